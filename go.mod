@@ -2,4 +2,8 @@ module github.com/orsinium-labs/valdo
 
 go 1.23.0
 
-require github.com/orsinium-labs/jsony v1.2.0
+require (
+	github.com/orsinium-labs/jsony v1.2.0
+	github.com/rivo/uniseg v0.4.7
+	golang.org/x/text v0.28.0
+)
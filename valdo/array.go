@@ -1,6 +1,10 @@
 package valdo
 
-import "github.com/orsinium-labs/jsony"
+import (
+	"context"
+
+	"github.com/orsinium-labs/jsony"
+)
 
 // ArrayType is constructed by [Array].
 type ArrayType struct {
@@ -21,29 +25,35 @@ func (a ArrayType) Constrain(cs ...Constraint[[]any]) ArrayType {
 
 // Validate implements [Validator].
 func (a ArrayType) Validate(data any) Error {
+	return a.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (a ArrayType) ValidateContext(ctx context.Context, data any) Error {
 	switch d := data.(type) {
 	case []any:
-		return a.validateArray(d)
+		return a.validateArray(ctx, d)
 	default:
 		return ErrType{Got: getTypeName(data), Expected: "array"}
 	}
 }
 
-func (a ArrayType) validateArray(data []any) Error {
+func (a ArrayType) validateArray(ctx context.Context, data []any) Error {
 	if data == nil {
 		return ErrType{Got: "null", Expected: "array"}
 	}
 	res := Errors{}
 	for i, val := range data {
-		err := a.elem.Validate(val)
+		err := validateContext(warnIndex(childContext(ctx), i), a.elem, val)
 		if err != nil {
 			res.Add(ErrIndex{Index: i, Err: err})
 			break
 		}
 	}
 	for _, c := range a.cs {
-		res.Add(c.check(data))
+		res.Add(c.validate(ctx, data))
 	}
+	getAnnotations(ctx).addAllItems()
 	return res.Flatten()
 }
 
@@ -56,10 +66,7 @@ func (a ArrayType) Schema() jsony.Object {
 	if len(items) > 0 {
 		res = append(res, jsony.Field{K: "items", V: items})
 	}
-	for _, c := range a.cs {
-		res = append(res, c.field)
-	}
-	return res
+	return appendConstraints(res, a.cs)
 }
 
 func getTypeName(v any) string {
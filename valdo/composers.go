@@ -1,6 +1,10 @@
 package valdo
 
-import "github.com/orsinium-labs/jsony"
+import (
+	"context"
+
+	"github.com/orsinium-labs/jsony"
+)
 
 type allOf struct {
 	vs []Validator
@@ -13,8 +17,13 @@ func AllOf(vs ...Validator) Validator {
 
 // Validate implements [Validator].
 func (n allOf) Validate(data any) Error {
+	return n.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (n allOf) ValidateContext(ctx context.Context, data any) Error {
 	for _, v := range n.vs {
-		err := v.Validate(data)
+		err := validateContext(ctx, v, data)
 		if err != nil {
 			return err
 		}
@@ -49,10 +58,21 @@ func AnyOf(vs ...Validator) Validator {
 
 // Validate implements [Validator].
 func (n anyOf) Validate(data any) Error {
+	return n.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (n anyOf) ValidateContext(ctx context.Context, data any) Error {
+	parent := getAnnotations(ctx)
+	if parent != nil {
+		return n.validateAnnotated(ctx, parent, data)
+	}
 	errors := Errors{}
 	for _, v := range n.vs {
-		err := v.Validate(data)
+		vCtx, warns := warnBuffer(ctx)
+		err := validateContext(vCtx, v, data)
 		if err == nil {
+			mergeWarnings(ctx, warns)
 			return nil
 		}
 		errors.Add(err)
@@ -60,6 +80,31 @@ func (n anyOf) Validate(data any) Error {
 	return ErrAnyOf{Errors: errors}
 }
 
+// validateAnnotated validates the data using all validators
+// and collects annotations from the ones that passed.
+//
+// Used for [UnevaluatedProperties] and [UnevaluatedItems].
+func (n anyOf) validateAnnotated(ctx context.Context, parent *annotations, data any) Error {
+	errors := Errors{}
+	passed := false
+	for _, v := range n.vs {
+		vCtx, ann := withAnnotations(ctx)
+		vCtx, warns := warnBuffer(vCtx)
+		err := validateContext(vCtx, v, data)
+		if err == nil {
+			passed = true
+			parent.merge(ann)
+			mergeWarnings(ctx, warns)
+		} else {
+			errors.Add(err)
+		}
+	}
+	if passed {
+		return nil
+	}
+	return ErrAnyOf{Errors: errors}
+}
+
 // Schema implements [Validator].
 func (n anyOf) Schema() jsony.Object {
 	ss := make(jsony.Array[jsony.Object], len(n.vs))
@@ -81,7 +126,14 @@ func Not(v Validator) Validator {
 
 // Validate implements [Validator].
 func (n notType) Validate(data any) Error {
-	err := n.v.Validate(data)
+	return n.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (n notType) ValidateContext(ctx context.Context, data any) Error {
+	// "not" never produces annotations, and warnings of the inner validator are dropped
+	vCtx, _ := warnBuffer(childContext(ctx))
+	err := validateContext(vCtx, n.v, data)
 	if err == nil {
 		return ErrNot{}
 	}
@@ -1,8 +1,12 @@
 package valdo
 
-import "github.com/orsinium-labs/jsony"
+import (
+	"context"
 
-type constVal[T string | bool | int] struct {
+	"github.com/orsinium-labs/jsony"
+)
+
+type constVal[T string | bool | int | float64] struct {
 	validator func(any) (T, Error)
 	value     T
 }
@@ -18,9 +22,14 @@ func (p constVal[T]) Validate(raw any) Error {
 	return nil
 }
 
+// ValidateContext implements [ContextValidator].
+func (p constVal[T]) ValidateContext(ctx context.Context, raw any) Error {
+	return p.Validate(raw)
+}
+
 // Schema implements [Validator].
 func (p constVal[T]) Schema() jsony.Object {
-	field := jsony.Field{K: "const", V: jsony.Detect(p.value)}
+	field := jsony.Field{K: "const", V: jsony.UnsafeDetect(p.value)}
 	return jsony.Object{field}
 }
 
@@ -59,3 +68,48 @@ func IntConst(value int) Validator {
 		value:     value,
 	}
 }
+
+// Float64Const restricts a value to a single number.
+//
+// https://json-schema.org/understanding-json-schema/reference/const
+func Float64Const(value float64) Validator {
+	return constVal[float64]{
+		validator: float64Validator,
+		value:     value,
+	}
+}
+
+type jsonConst struct {
+	value jsonValue
+}
+
+// JSONConst restricts a value to a single JSON value of any type, including null,
+// arrays, and objects. The value is compared the same way as in [JSONEnum].
+//
+// Panics if the value is not valid JSON.
+//
+//	valdo.JSONConst(jsony.Null)
+//	valdo.JSONConst(valdo.RawJSON(`{"x": 0, "y": 0}`))
+//
+// https://json-schema.org/understanding-json-schema/reference/const
+func JSONConst(value jsony.Encoder) Validator {
+	return jsonConst{newJSONValue(value)}
+}
+
+// Validate implements [Validator].
+func (c jsonConst) Validate(data any) Error {
+	if canonicalKey(data) != c.value.key {
+		return ErrConst{Got: data, Expected: c.value.param}
+	}
+	return nil
+}
+
+// ValidateContext implements [ContextValidator].
+func (c jsonConst) ValidateContext(ctx context.Context, data any) Error {
+	return c.Validate(data)
+}
+
+// Schema implements [Validator].
+func (c jsonConst) Schema() jsony.Object {
+	return jsony.Object{jsony.Field{K: "const", V: c.value.raw}}
+}
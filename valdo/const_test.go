@@ -3,6 +3,7 @@ package valdo_test
 import (
 	"testing"
 
+	"github.com/orsinium-labs/jsony"
 	"github.com/orsinium-labs/valdo/valdo"
 )
 
@@ -45,3 +46,54 @@ func TestConst_Schema(t *testing.T) {
 	isEq(string(valdo.Schema(valdo.BoolConst(true))), `{"const":true}`)
 	isEq(string(valdo.Schema(valdo.IntConst(13))), `{"const":13}`)
 }
+
+func TestFloat64Const(t *testing.T) {
+	t.Parallel()
+	val := valdo.Float64Const(2.5)
+	noErr(valdo.Validate(val, []byte(`2.5`)))
+	err := valdo.Validate(val, []byte(`2`))
+	isErr[valdo.ErrConst](err)
+	isEq(err.Error(), "expected the value to be equal to 2.5")
+	err = valdo.Validate(valdo.DefaultLocales.Wrap("de", val), []byte(`2`))
+	isEq(err.Error(), "erwartet, dass der Wert gleich 2,5 ist")
+	isErr[valdo.ErrType](valdo.Validate(val, []byte(`"2.5"`)))
+	isEq(string(valdo.Schema(val)), `{"const":2.5}`)
+}
+
+func TestJSONConst(t *testing.T) {
+	t.Parallel()
+	val := valdo.JSONConst(valdo.RawJSON(`{"x": 1, "y": [true, null]}`))
+	noErr(valdo.Validate(val, []byte(`{"y": [true, null], "x": 1.0}`)))
+	isErr[valdo.ErrConst](valdo.Validate(val, []byte(`{"x": 1, "y": [true]}`)))
+	isErr[valdo.ErrConst](valdo.Validate(val, []byte(`{"x": 1, "y": [true, null], "z": 0}`)))
+	isErr[valdo.ErrConst](valdo.Validate(val, []byte(`null`)))
+	err := valdo.Validate(val, []byte(`1`))
+	isEq(err.Error(), `expected the value to be equal to {"x":1,"y":[true,null]}`)
+	isEq(string(jsony.EncodeBytes(valdo.ErrorJSON(err))),
+		`{"code":"const","message":"expected the value to be equal to {\"x\":1,\"y\":[true,null]}","params":{"expected":{"x":1,"y":[true,null]}}}`)
+	isEq(string(valdo.Schema(val)), `{"const":{"x":1,"y":[true,null]}}`)
+
+	val = valdo.JSONConst(jsony.Null)
+	noErr(valdo.Validate(val, []byte(`null`)))
+	isErr[valdo.ErrConst](valdo.Validate(val, []byte(`0`)))
+	isEq(string(valdo.Schema(val)), `{"const":null}`)
+
+	val = valdo.JSONConst(jsony.String("hi"))
+	noErr(valdo.Validate(val, []byte(`"hi"`)))
+	err = valdo.Validate(val, []byte(`"hello"`))
+	isEq(err.Error(), `expected the value to be equal to "hi"`)
+
+	val = valdo.JSONConst(jsony.String("null"))
+	err = valdo.Validate(val, []byte(`null`))
+	isEq(err.Error(), `expected the value to be equal to "null"`)
+	isEq(string(jsony.EncodeBytes(valdo.ErrorJSON(err))),
+		`{"code":"const","message":"expected the value to be equal to \"null\"","params":{"expected":"null"}}`)
+}
+
+func TestJSONConst_Invalid(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		isEq(recover(), any("invalid JSON: unexpected end of JSON input"))
+	}()
+	valdo.JSONConst(valdo.RawJSON(`{"x":`))
+}
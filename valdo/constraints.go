@@ -1,15 +1,33 @@
 package valdo
 
 import (
+	"context"
+	"math/big"
 	"regexp"
+	"strconv"
+	"unicode/utf8"
 
 	"github.com/orsinium-labs/jsony"
 	"github.com/orsinium-labs/valdo/internal"
+	"github.com/rivo/uniseg"
 )
 
 type Constraint[T any] struct {
 	check func(T) Error
-	field jsony.Field
+	// checkCtx, if not nil, is used instead of check by context-aware constraints.
+	checkCtx func(context.Context, T) Error
+	fields   jsony.Object
+	msgs     Messages
+	// props are the names of the object properties the constraint refers to.
+	props []string
+}
+
+// validate the value using the constraint.
+func (c Constraint[T]) validate(ctx context.Context, v T) Error {
+	if c.checkCtx != nil {
+		return c.checkCtx(ctx, v)
+	}
+	return c.check(v)
 }
 
 func jsonyNumber[T internal.Number](v T) jsony.Encoder {
@@ -25,24 +43,53 @@ func jsonyNumber[T internal.Number](v T) jsony.Encoder {
 
 // The value must be a multiple of the given number.
 //
+// Floats are checked using exact decimal arithmetic on the shortest decimal
+// representation of the numbers. So, 0.3 is a multiple of 0.1,
+// even though it's not in floating point arithmetic.
+//
+// The check is done on the decoded float64 (or float32), not on the raw JSON text,
+// so float64 precision still applies: numbers with more than 15 significant digits
+// may be rounded before the check, and 0.30000000000000001 is accepted as 0.3.
+// Use [Decimal] with [DecimalMultipleOf] when every digit matters.
+//
 // https://json-schema.org/understanding-json-schema/reference/numeric#multiples
-func MultipleOf[T internal.Integer](v T) Constraint[T] {
+func MultipleOf[T internal.Number](v T) Constraint[T] {
 	if v <= 0 {
 		panic("the value must be positive")
 	}
 	c := func(f T) Error {
-		// TODO: support float64 as well
-		if f%v == 0 {
+		if isMultipleOf(f, v) {
 			return nil
 		}
 		return ErrMultipleOf{Value: v}
 	}
 	return Constraint[T]{
-		check: c,
-		field: jsony.Field{K: "multipleOf", V: jsonyNumber(v)},
+		check:  c,
+		fields: jsony.Object{{K: "multipleOf", V: jsonyNumber(v)}},
 	}
 }
 
+// isMultipleOf reports if f is a multiple of the positive number v.
+func isMultipleOf[T internal.Number](f, v T) bool {
+	if T(1)/2 == 0 {
+		// Integer division truncates, so the result is the same only for multiples.
+		return f/v*v == f
+	}
+	bitSize := 64
+	x := 1<<24 + 1
+	if T(x) == T(x-1) {
+		// float32 cannot represent 2^24+1.
+		bitSize = 32
+	}
+	fr, okF := new(big.Rat).SetString(strconv.FormatFloat(float64(f), 'g', -1, bitSize))
+	vr, okV := new(big.Rat).SetString(strconv.FormatFloat(float64(v), 'g', -1, bitSize))
+	if !okF || !okV {
+		// NaN or infinity
+		return false
+	}
+	return fr.Quo(fr, vr).IsInt()
+}
+
 func Min[T internal.Number](v T) Constraint[T] {
 	c := func(f T) Error {
 		if f >= v {
@@ -51,8 +98,8 @@ func Min[T internal.Number](v T) Constraint[T] {
 		return ErrMin{Value: v}
 	}
 	return Constraint[T]{
-		check: c,
-		field: jsony.Field{K: "minimum", V: jsonyNumber(v)},
+		check:  c,
+		fields: jsony.Object{{K: "minimum", V: jsonyNumber(v)}},
 	}
 }
 
@@ -64,8 +111,8 @@ func ExclMin[T internal.Number](v T) Constraint[T] {
 		return ErrExclMin{Value: v}
 	}
 	return Constraint[T]{
-		check: c,
-		field: jsony.Field{K: "exclusiveMinimum", V: jsonyNumber(v)},
+		check:  c,
+		fields: jsony.Object{{K: "exclusiveMinimum", V: jsonyNumber(v)}},
 	}
 }
 
@@ -77,8 +124,8 @@ func Max[T internal.Number](v T) Constraint[T] {
 		return ErrMax{Value: v}
 	}
 	return Constraint[T]{
-		check: c,
-		field: jsony.Field{K: "maximum", V: jsonyNumber(v)},
+		check:  c,
+		fields: jsony.Object{{K: "maximum", V: jsonyNumber(v)}},
 	}
 }
 
@@ -90,36 +137,100 @@ func ExclMax[T internal.Number](v T) Constraint[T] {
 		return ErrExclMax{Value: v}
 	}
 	return Constraint[T]{
-		check: c,
-		field: jsony.Field{K: "exclusiveMaximum", V: jsonyNumber(v)},
+		check:  c,
+		fields: jsony.Object{{K: "exclusiveMaximum", V: jsonyNumber(v)}},
 	}
 }
 
+// MinLen requires the string to have at least the given number of characters.
+//
+// Characters are counted as Unicode code points, as JSON Schema requires.
+// See [MinGraphemes] for counting user-perceived characters.
 func MinLen(min uint) Constraint[string] {
 	minInt := int(min)
 	c := func(f string) Error {
-		if len(f) >= minInt {
+		if utf8.RuneCountInString(f) >= minInt {
 			return nil
 		}
 		return ErrMinLen{Value: minInt}
 	}
 	return Constraint[string]{
-		check: c,
-		field: jsony.Field{K: "minLength", V: jsony.UInt(min)},
+		check:  c,
+		fields: jsony.Object{{K: "minLength", V: jsony.UInt(min)}},
 	}
 }
 
+// MaxLen requires the string to have at most the given number of characters.
+//
+// Characters are counted as Unicode code points, as JSON Schema requires.
+// See [MaxBytes] for limiting the size of the string in UTF-8
+// and [MaxGraphemes] for counting user-perceived characters.
 func MaxLen(min uint) Constraint[string] {
 	minInt := int(min)
 	c := func(f string) Error {
-		if len(f) <= minInt {
+		if utf8.RuneCountInString(f) <= minInt {
 			return nil
 		}
 		return ErrMaxLen{Value: minInt}
 	}
 	return Constraint[string]{
-		check: c,
-		field: jsony.Field{K: "maxLength", V: jsony.UInt(min)},
+		check:  c,
+		fields: jsony.Object{{K: "maxLength", V: jsony.UInt(min)}},
+	}
+}
+
+// MaxBytes requires the string to be at most the given number of bytes long in UTF-8.
+//
+// Useful for database columns limited in bytes.
+// There is no JSON Schema keyword for it, so "x-maxBytes" extension keyword is used.
+func MaxBytes(max uint) Constraint[string] {
+	maxInt := int(max)
+	c := func(f string) Error {
+		if len(f) <= maxInt {
+			return nil
+		}
+		return ErrMaxBytes{Value: maxInt}
+	}
+	return Constraint[string]{
+		check:  c,
+		fields: jsony.Object{{K: "x-maxBytes", V: jsony.UInt(max)}},
+	}
+}
+
+// MinGraphemes requires the string to have at least the given number of grapheme clusters.
+//
+// A grapheme cluster is what users perceive as a single character,
+// like an emoji with a skin tone modifier or a letter with combining accents.
+// There is no JSON Schema keyword for it, so "x-minGraphemes" extension keyword is used.
+func MinGraphemes(min uint) Constraint[string] {
+	minInt := int(min)
+	c := func(f string) Error {
+		if uniseg.GraphemeClusterCount(f) >= minInt {
+			return nil
+		}
+		return ErrMinGraphemes{Value: minInt}
+	}
+	return Constraint[string]{
+		check:  c,
+		fields: jsony.Object{{K: "x-minGraphemes", V: jsony.UInt(min)}},
+	}
+}
+
+// MaxGraphemes requires the string to have at most the given number of grapheme clusters.
+//
+// Useful to match character counters in UI.
+// There is no JSON Schema keyword for it, so "x-maxGraphemes" extension keyword is used.
+func MaxGraphemes(max uint) Constraint[string] {
+	maxInt := int(max)
+	c := func(f string) Error {
+		if uniseg.GraphemeClusterCount(f) <= maxInt {
+			return nil
+		}
+		return ErrMaxGraphemes{Value: maxInt}
+	}
+	return Constraint[string]{
+		check:  c,
+		fields: jsony.Object{{K: "x-maxGraphemes", V: jsony.UInt(max)}},
 	}
 }
 
@@ -132,28 +243,105 @@ func Pattern(r string) Constraint[string] {
 		return ErrPattern{}
 	}
 	return Constraint[string]{
-		check: c,
-		field: jsony.Field{K: "pattern", V: jsony.String(r)},
+		check:  c,
+		fields: jsony.Object{{K: "pattern", V: jsony.String(r)}},
 	}
 }
 
+// Contains requires at least one item of the array to match the validator.
+//
+// If no items match, [ErrContains] contains the errors for all items.
+//
+// https://json-schema.org/understanding-json-schema/reference/array#contains
 func Contains(v Validator) Constraint[[]any] {
-	c := func(items []any) Error {
-		var err Error
-		for _, item := range items {
-			err = v.Validate(item)
-			if err == nil {
-				return nil
-			}
+	c := func(ctx context.Context, items []any) Error {
+		if len(items) == 0 {
+			return ErrContains{Err: ErrMinItems{Value: 1}}
+		}
+		count, errs := countMatching(ctx, v, items)
+		if count > 0 {
+			return nil
+		}
+		return ErrContains{Err: errs.Flatten()}
+	}
+	return Constraint[[]any]{
+		checkCtx: c,
+		fields:   jsony.Object{{K: "contains", V: v.Schema()}},
+	}
+}
+
+// MinContains requires at least the given number of items to match the validator.
+//
+// https://json-schema.org/understanding-json-schema/reference/array#mincontains-maxcontains
+func MinContains(v Validator, min uint) Constraint[[]any] {
+	minInt := int(min)
+	c := func(ctx context.Context, items []any) Error {
+		count, _ := countMatching(ctx, v, items)
+		if count >= minInt {
+			return nil
 		}
-		return ErrContains{Err: err}
+		return ErrMinContains{Value: minInt, Count: count}
 	}
 	return Constraint[[]any]{
-		check: c,
-		field: jsony.Field{K: "contains", V: v.Schema()},
+		checkCtx: c,
+		fields: jsony.Object{
+			{K: "contains", V: v.Schema()},
+			{K: "minContains", V: jsony.UInt(min)},
+		},
 	}
 }
 
+// MaxContains requires at least one and at most the given number of items to match the validator.
+//
+// Like "maxContains" in JSON Schema, it rejects arrays without matching items
+// with [ErrMinContains].
+//
+// https://json-schema.org/understanding-json-schema/reference/array#mincontains-maxcontains
+func MaxContains(v Validator, max uint) Constraint[[]any] {
+	maxInt := int(max)
+	c := func(ctx context.Context, items []any) Error {
+		count, _ := countMatching(ctx, v, items)
+		if count == 0 {
+			return ErrMinContains{Value: 1, Count: 0}
+		}
+		if count <= maxInt {
+			return nil
+		}
+		return ErrMaxContains{Value: maxInt, Count: count}
+	}
+	return Constraint[[]any]{
+		checkCtx: c,
+		fields: jsony.Object{
+			{K: "contains", V: v.Schema()},
+			{K: "maxContains", V: jsony.UInt(max)},
+		},
+	}
+}
+
+// countMatching returns the number of items that pass the validator
+// and the errors of the items that don't, wrapped into [ErrIndex].
+//
+// The matching items are marked as evaluated for [UnevaluatedItems]
+// and their warnings are reported wrapped into [ErrIndex].
+func countMatching(ctx context.Context, v Validator, items []any) (int, Errors) {
+	ann := getAnnotations(ctx)
+	childCtx := childContext(ctx)
+	count := 0
+	errs := Errors{}
+	for i, item := range items {
+		itemCtx, warns := warnBuffer(childCtx)
+		err := validateContext(itemCtx, v, item)
+		if err != nil {
+			errs.Add(ErrIndex{Index: i, Err: err})
+			continue
+		}
+		ann.addItem(i)
+		mergeWarnings(warnIndex(ctx, i), warns)
+		count++
+	}
+	return count, errs
+}
+
 func MinItems(min uint) Constraint[[]any] {
 	minInt := int(min)
 	c := func(f []any) Error {
@@ -163,8 +351,8 @@ func MinItems(min uint) Constraint[[]any] {
 		return ErrMinItems{Value: minInt}
 	}
 	return Constraint[[]any]{
-		check: c,
-		field: jsony.Field{K: "minItems", V: jsony.UInt(min)},
+		check:  c,
+		fields: jsony.Object{{K: "minItems", V: jsony.UInt(min)}},
 	}
 }
 
@@ -177,17 +365,17 @@ func MaxItems(min uint) Constraint[[]any] {
 		return ErrMaxItems{Value: minInt}
 	}
 	return Constraint[[]any]{
-		check: c,
-		field: jsony.Field{K: "maxItems", V: jsony.UInt(min)},
+		check:  c,
+		fields: jsony.Object{{K: "maxItems", V: jsony.UInt(min)}},
 	}
 }
 
 func PropertyNames(cs ...Constraint[string]) Constraint[map[string]any] {
-	c := func(items map[string]any) Error {
+	c := func(ctx context.Context, items map[string]any) Error {
 		res := Errors{}
 		for _, c := range cs {
 			for name := range items {
-				err := c.check(name)
+				err := c.validate(ctx, name)
 				if err != nil {
 					res.Add(ErrPropertyNames{Name: name, Err: err})
 				}
@@ -195,13 +383,10 @@ func PropertyNames(cs ...Constraint[string]) Constraint[map[string]any] {
 		}
 		return res.Flatten()
 	}
-	schema := make(jsony.Object, len(cs))
-	for i, c := range cs {
-		schema[i] = c.field
-	}
+	schema := appendConstraints(make(jsony.Object, 0, len(cs)), cs)
 	return Constraint[map[string]any]{
-		check: c,
-		field: jsony.Field{K: "propertyNames", V: schema},
+		checkCtx: c,
+		fields:   jsony.Object{{K: "propertyNames", V: schema}},
 	}
 }
 
@@ -214,8 +399,8 @@ func MinProperties(min uint) Constraint[map[string]any] {
 		return ErrMinProperties{Value: minInt}
 	}
 	return Constraint[map[string]any]{
-		check: c,
-		field: jsony.Field{K: "minProperties", V: jsony.UInt(min)},
+		check:  c,
+		fields: jsony.Object{{K: "minProperties", V: jsony.UInt(min)}},
 	}
 }
 
@@ -228,8 +413,8 @@ func MaxProperties(min uint) Constraint[map[string]any] {
 		return ErrMaxProperties{Value: minInt}
 	}
 	return Constraint[map[string]any]{
-		check: c,
-		field: jsony.Field{K: "maxProperties", V: jsony.UInt(min)},
+		check:  c,
+		fields: jsony.Object{{K: "maxProperties", V: jsony.UInt(min)}},
 	}
 }
 
@@ -241,7 +426,7 @@ func Equals[T bool | string | int](exp T) Constraint[T] {
 		return nil
 	}
 	return Constraint[T]{
-		check: c,
-		field: jsony.Field{K: "const", V: jsony.Detect(exp)},
+		check:  c,
+		fields: jsony.Object{{K: "const", V: jsony.Detect(exp)}},
 	}
 }
@@ -3,6 +3,7 @@ package valdo_test
 import (
 	"testing"
 
+	"github.com/orsinium-labs/jsony"
 	"github.com/orsinium-labs/valdo/valdo"
 )
 
@@ -23,6 +24,34 @@ func TestMultipleOf(t *testing.T) {
 	isEq(string(valdo.Schema(val)), `{"type":"integer","multipleOf":3}`)
 }
 
+func TestMultipleOf_Float(t *testing.T) {
+	t.Parallel()
+	val := valdo.Float64(valdo.MultipleOf(0.01))
+	noErr(valdo.Validate(val, []byte(`0`)))
+	noErr(valdo.Validate(val, []byte(`12`)))
+	noErr(valdo.Validate(val, []byte(`12.5`)))
+	noErr(valdo.Validate(val, []byte(`12.34`)))
+	noErr(valdo.Validate(val, []byte(`-0.07`)))
+	noErr(valdo.Validate(val, []byte(`1e3`)))
+	err := valdo.Validate(val, []byte(`12.345`))
+	isErr[valdo.ErrMultipleOf](err)
+	isEq(err.Error(), "must be a multiple of 0.01")
+	isErr[valdo.ErrMultipleOf](valdo.Validate(val, []byte(`1e-3`)))
+	isEq(string(valdo.Schema(val)), `{"type":"number","multipleOf":0.01}`)
+
+	// 0.3 / 0.1 is 2.9999999999999996 in floating point arithmetic
+	val = valdo.Float64(valdo.MultipleOf(0.1))
+	noErr(valdo.Validate(val, []byte(`0.3`)))
+	noErr(valdo.Validate(val, []byte(`0.7`)))
+	isErr[valdo.ErrMultipleOf](valdo.Validate(val, []byte(`0.35`)))
+	// the input is decoded as float64 first, so the extra digits are lost
+	noErr(valdo.Validate(val, []byte(`0.30000000000000001`)))
+
+	val = valdo.Float64(valdo.MultipleOf(1.5))
+	noErr(valdo.Validate(val, []byte(`4.5`)))
+	isErr[valdo.ErrMultipleOf](valdo.Validate(val, []byte(`4`)))
+}
+
 func TestMin(t *testing.T) {
 	t.Parallel()
 	val := valdo.Int(valdo.Min(3))
@@ -84,6 +113,9 @@ func TestMinLen(t *testing.T) {
 	isErr[valdo.ErrMinLen](valdo.Validate(val, []byte(`"hi"`)))
 	isErr[valdo.ErrMinLen](valdo.Validate(val, []byte(`"!"`)))
 	isErr[valdo.ErrMinLen](valdo.Validate(val, []byte(`""`)))
+	// code points are counted, not bytes
+	noErr(valdo.Validate(val, []byte(`"süß"`)))
+	isErr[valdo.ErrMinLen](valdo.Validate(val, []byte(`"ßß"`)))
 
 	isEq(string(valdo.Schema(val)), `{"type":"string","minLength":3}`)
 }
@@ -97,10 +129,41 @@ func TestMaxLen(t *testing.T) {
 	noErr(valdo.Validate(val, []byte(`""`)))
 	isErr[valdo.ErrMaxLen](valdo.Validate(val, []byte(`"hell"`)))
 	isErr[valdo.ErrMaxLen](valdo.Validate(val, []byte(`"hello"`)))
+	// code points are counted, not bytes
+	noErr(valdo.Validate(val, []byte(`"übe"`)))
+	noErr(valdo.Validate(val, []byte(`"日本語"`)))
+	isErr[valdo.ErrMaxLen](valdo.Validate(val, []byte(`"über"`)))
 
 	isEq(string(valdo.Schema(val)), `{"type":"string","maxLength":3}`)
 }
 
+func TestMaxBytes(t *testing.T) {
+	t.Parallel()
+	val := valdo.String(valdo.MaxBytes(4))
+	noErr(valdo.Validate(val, []byte(`"hell"`)))
+	noErr(valdo.Validate(val, []byte(`"übe"`)))
+	isErr[valdo.ErrMaxBytes](valdo.Validate(val, []byte(`"über"`)))
+	err := valdo.Validate(val, []byte(`"日本語"`))
+	isErr[valdo.ErrMaxBytes](err)
+	isEq(err.Error(), "must be at most 4 bytes long")
+
+	isEq(string(valdo.Schema(val)), `{"type":"string","x-maxBytes":4}`)
+}
+
+func TestGraphemes(t *testing.T) {
+	t.Parallel()
+	val := valdo.String(valdo.MinGraphemes(2), valdo.MaxGraphemes(3))
+	// "e" with a combining accent, a family emoji, and a flag: 3 graphemes, 11 code points
+	noErr(valdo.Validate(val, []byte(`"e\u0301\ud83d\udc68\u200d\ud83d\udc69\u200d\ud83d\udc67\ud83c\uddfa\ud83c\udde6"`)))
+	noErr(valdo.Validate(val, []byte(`"ab"`)))
+	isErr[valdo.ErrMinGraphemes](valdo.Validate(val, []byte(`"e\u0301"`)))
+	isErr[valdo.ErrMaxGraphemes](valdo.Validate(val, []byte(`"abcd"`)))
+	isEq(string(jsony.EncodeBytes(valdo.ErrorJSON(valdo.ErrMaxGraphemes{Value: 3}))),
+		`{"code":"max_graphemes","message":"must be at most 3 characters long","params":{"value":3}}`)
+
+	isEq(string(valdo.Schema(val)), `{"type":"string","x-minGraphemes":2,"x-maxGraphemes":3}`)
+}
+
 func TestPattern(t *testing.T) {
 	t.Parallel()
 	val := valdo.String(valdo.Pattern("[AB]"))
@@ -129,7 +192,65 @@ func TestContains(t *testing.T) {
 	isErr[valdo.ErrContains](valdo.Validate(val, []byte(`[true, 2.3]`)))
 	isErr[valdo.ErrContains](valdo.Validate(val, []byte(`[true]`)))
 
+	isEq(
+		valdo.Validate(val, []byte(`[true, 2.3]`)).Error(),
+		"no item matches: at 0: invalid type: got boolean, expected integer; at 1: invalid type: got number, expected integer",
+	)
+	isEq(valdo.Validate(val, []byte(`[]`)).Error(), "no item matches: must contain at least 1 item")
+
 	isEq(string(valdo.Schema(val)), `{"type":"array","contains":{"type":"integer"}}`)
+
+	// each item is checked only once
+	calls := 0
+	count := valdo.Func(func(any) bool { calls++; return false }, valdo.ErrNot{}, nil)
+	isErr[valdo.ErrContains](valdo.Validate(valdo.Array(valdo.Any(), valdo.Contains(count)), []byte(`[1, 2]`)))
+	isEq(calls, 2)
+}
+
+func TestMinContains(t *testing.T) {
+	t.Parallel()
+	val := valdo.Array(valdo.Any(), valdo.MinContains(valdo.Int(), 2))
+	noErr(valdo.Validate(val, []byte(`[1, 2]`)))
+	noErr(valdo.Validate(val, []byte(`[1, "", 2, 3]`)))
+	isErr[valdo.ErrMinContains](valdo.Validate(val, []byte(`[]`)))
+	err := valdo.Validate(val, []byte(`[1, "", true]`))
+	isErr[valdo.ErrMinContains](err)
+	isEq(err.Error(), "must contain at least 2 matching items, found 1")
+	err = valdo.Validate(valdo.DefaultLocales.Wrap("ru", val), []byte(`[1, "", true]`))
+	isEq(err.Error(), "должно содержать как минимум 2 подходящих элемента, найдено: 1")
+
+	isEq(string(valdo.Schema(val)), `{"type":"array","contains":{"type":"integer"},"minContains":2}`)
+}
+
+func TestMaxContains(t *testing.T) {
+	t.Parallel()
+	val := valdo.Array(
+		valdo.Any(),
+		valdo.Contains(valdo.Int()),
+		valdo.MaxContains(valdo.Int(), 1),
+	)
+	noErr(valdo.Validate(val, []byte(`[1, ""]`)))
+	err := valdo.Validate(val, []byte(`[1, "", 2, 3]`))
+	isErr[valdo.ErrMaxContains](err)
+	isEq(err.Error(), "must contain at most 1 matching item, found 3")
+
+	// as in JSON Schema, at least one item must match
+	err = valdo.Validate(valdo.Array(valdo.Any(), valdo.MaxContains(valdo.Int(), 1)), []byte(`[""]`))
+	isErr[valdo.ErrMinContains](err)
+	isEq(err.Error(), "must contain at least 1 matching item, found 0")
+
+	isEq(string(valdo.Schema(val)), `{"type":"array","contains":{"type":"integer"},"maxContains":1}`)
+
+	// different validators cannot share the same "contains" keyword
+	val = valdo.Array(
+		valdo.Any(),
+		valdo.MinContains(valdo.Int(), 1),
+		valdo.MaxContains(valdo.String(), 1),
+	)
+	isEq(
+		string(valdo.Schema(val)),
+		`{"type":"array","contains":{"type":"integer"},"minContains":1,"allOf":[{"contains":{"type":"string"},"maxContains":1}]}`,
+	)
 }
 
 func TestMinItems(t *testing.T) {
@@ -0,0 +1,133 @@
+package valdo
+
+import (
+	"context"
+
+	"github.com/orsinium-labs/jsony"
+)
+
+type funcVal struct {
+	check  func(any) bool
+	err    Error
+	schema jsony.Object
+}
+
+// Func creates a custom validator.
+//
+// The check function accepts the data decoded from JSON (see [Validator])
+// and returns false if the data is invalid, in which case err is returned.
+// Define a custom error type to be able to translate it using [Locale].
+// Panics if err is nil.
+//
+// The schema is a JSON Schema fragment describing the check.
+// If it's nil, the validator accepts any value in the generated schema.
+func Func(check func(any) bool, err Error, schema jsony.Object) Validator {
+	mustHaveError(err)
+	if schema == nil {
+		schema = jsony.Object{}
+	}
+	return funcVal{check: check, err: err, schema: schema}
+}
+
+// Validate implements [Validator].
+func (f funcVal) Validate(data any) Error {
+	if f.check(data) {
+		return nil
+	}
+	return f.err
+}
+
+// ValidateContext implements [ContextValidator].
+func (f funcVal) ValidateContext(ctx context.Context, data any) Error {
+	return f.Validate(data)
+}
+
+// Schema implements [Validator].
+func (f funcVal) Schema() jsony.Object {
+	return f.schema
+}
+
+// Check creates a custom constraint.
+//
+// The check function returns false if the value is invalid,
+// in which case err is returned. The schema is a JSON Schema fragment
+// added to the schema of the validator. It can be nil but err cannot.
+//
+//	valdo.Int(valdo.Check(isEven, ErrOdd{}, nil))
+func Check[T any](check func(T) bool, err Error, schema jsony.Object) Constraint[T] {
+	mustHaveError(err)
+	c := func(v T) Error {
+		if check(v) {
+			return nil
+		}
+		return err
+	}
+	return Constraint[T]{check: c, fields: schema}
+}
+
+type funcCtxVal struct {
+	check  func(context.Context, any) (bool, error)
+	err    Error
+	schema jsony.Object
+}
+
+// FuncContext creates a custom context-aware validator.
+//
+// It's the same as [Func] but the check function also accepts the context
+// passed into [ValidateContext] and can fail. If the check fails or the context
+// is canceled, [ErrCheck] is returned.
+func FuncContext(check func(context.Context, any) (bool, error), err Error, schema jsony.Object) Validator {
+	mustHaveError(err)
+	if schema == nil {
+		schema = jsony.Object{}
+	}
+	return funcCtxVal{check: check, err: err, schema: schema}
+}
+
+// Validate implements [Validator].
+func (f funcCtxVal) Validate(data any) Error {
+	return f.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (f funcCtxVal) ValidateContext(ctx context.Context, data any) Error {
+	return runCheck(ctx, f.check, data, f.err)
+}
+
+// Schema implements [Validator].
+func (f funcCtxVal) Schema() jsony.Object {
+	return f.schema
+}
+
+// CheckContext creates a custom context-aware constraint.
+//
+// It's the same as [Check] but the check function also accepts the context
+// passed into [ValidateContext] and can fail. If the check fails or the context
+// is canceled, [ErrCheck] is returned.
+func CheckContext[T any](check func(context.Context, T) (bool, error), err Error, schema jsony.Object) Constraint[T] {
+	mustHaveError(err)
+	c := func(ctx context.Context, v T) Error {
+		return runCheck(ctx, check, v, err)
+	}
+	return Constraint[T]{checkCtx: c, fields: schema}
+}
+
+// mustHaveError panics if the error for failed custom checks is nil,
+// so that invalid values don't pass silently.
+func mustHaveError(err Error) {
+	if err == nil {
+		panic("the error must not be nil")
+	}
+}
+
+// runCheck runs the context-aware check and converts the result into an error.
+func runCheck[T any](ctx context.Context, check func(context.Context, T) (bool, error), v T, err Error) Error {
+	ok, checkErr := runLookup(ctx, check, v)
+	if checkErr != nil {
+		return checkErr
+	}
+	if !ok {
+		return err
+	}
+	return nil
+}
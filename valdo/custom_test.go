@@ -0,0 +1,137 @@
+package valdo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/orsinium-labs/jsony"
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+type ErrNoDigits struct {
+	Format string
+	Count  int
+}
+
+func (e ErrNoDigits) GetDefault() valdo.Error { return ErrNoDigits{} }
+func (e ErrNoDigits) Code() string            { return "no_digits" }
+func (e ErrNoDigits) Params() map[string]any  { return map[string]any{"count": e.Count} }
+
+func (e ErrNoDigits) SetFormat(f string) valdo.Error {
+	e.Format = f
+	return e
+}
+
+func (e ErrNoDigits) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must contain {count} digits"
+	}
+	return strings.ReplaceAll(f, "{count}", fmt.Sprint(e.Count))
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	hasDigit := func(s string) bool {
+		return strings.ContainsAny(s, "0123456789")
+	}
+	val := valdo.String(valdo.Check(hasDigit, ErrNoDigits{Count: 1}, jsony.Object{
+		jsony.Field{K: "pattern", V: jsony.SafeString("[0-9]")},
+	}))
+	noErr(valdo.Validate(val, []byte(`"hunter2"`)))
+	isErr[ErrNoDigits](valdo.Validate(val, []byte(`"hunter"`)))
+	isEq(valdo.Validate(val, []byte(`"hunter"`)).Error(), "must contain 1 digits")
+	isEq(string(valdo.Schema(val)), `{"type":"string","pattern":"[0-9]"}`)
+
+	// custom errors can be translated
+	loc := valdo.Locale{
+		ErrNoDigits{}: "moet {count, plural, one {# cijfer} other {# cijfers}} bevatten",
+	}
+	isEq(valdo.Validate(loc.Wrap(val), []byte(`"hunter"`)).Error(), "moet 1 cijfer bevatten")
+
+	// schema can be omitted
+	val = valdo.String(valdo.Check(hasDigit, ErrNoDigits{}, nil).Message("no digits"))
+	isEq(valdo.Validate(val, []byte(`"hunter"`)).Error(), "no digits")
+	isEq(string(valdo.Schema(val)), `{"type":"string"}`)
+}
+
+func TestFunc(t *testing.T) {
+	t.Parallel()
+	isShort := func(data any) bool {
+		s, ok := data.(string)
+		return ok && len(s) < 4
+	}
+	val := valdo.Func(isShort, ErrNoDigits{}, nil)
+	noErr(valdo.Validate(val, []byte(`"abc"`)))
+	isErr[ErrNoDigits](valdo.Validate(val, []byte(`"abcd"`)))
+	isErr[ErrNoDigits](valdo.Validate(val, []byte(`12`)))
+	isEq(string(valdo.Schema(val)), `{}`)
+
+	val = valdo.Func(isShort, ErrNoDigits{}, jsony.Object{
+		jsony.Field{K: "type", V: jsony.SafeString("string")},
+		jsony.Field{K: "maxLength", V: jsony.Int(3)},
+	})
+	isEq(string(valdo.Schema(val)), `{"type":"string","maxLength":3}`)
+}
+
+type ctxKey struct{}
+
+func TestCheckContext(t *testing.T) {
+	t.Parallel()
+	check := func(ctx context.Context, s string) (bool, error) {
+		allowed := ctx.Value(ctxKey{}).(string)
+		return s == allowed, nil
+	}
+	val := valdo.Object(
+		valdo.P("names", valdo.Array(valdo.String(valdo.CheckContext(check, ErrNoDigits{}, nil)))),
+	)
+	ctx := context.WithValue(context.Background(), ctxKey{}, "aragorn")
+	noErr(valdo.ValidateContext(ctx, val, []byte(`{"names": ["aragorn"]}`)))
+	isErr[valdo.ErrProperty](valdo.ValidateContext(ctx, val, []byte(`{"names": ["legolas"]}`)))
+
+	failing := func(ctx context.Context, s string) (bool, error) {
+		return false, errors.New("database is down")
+	}
+	failingVal := valdo.String(valdo.CheckContext(failing, ErrNoDigits{}, nil).Message("no digits"))
+	err := valdo.ValidateContext(ctx, failingVal, []byte(`"aragorn"`))
+	isEq(err.Error(), "no digits")
+	isEq(errors.Unwrap(errors.Unwrap(err)).Error(), "database is down")
+}
+
+func TestFuncContext(t *testing.T) {
+	t.Parallel()
+	check := func(ctx context.Context, data any) (bool, error) {
+		return data == ctx.Value(ctxKey{}), nil
+	}
+	val := valdo.FuncContext(check, ErrNoDigits{}, nil)
+	ctx := context.WithValue(context.Background(), ctxKey{}, "aragorn")
+	noErr(valdo.ValidateContext(ctx, val, []byte(`"aragorn"`)))
+	isErr[ErrNoDigits](valdo.ValidateContext(ctx, val, []byte(`"legolas"`)))
+	isEq(string(valdo.Schema(val)), `{}`)
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	isErr[valdo.ErrCheck](valdo.ValidateContext(ctx, val, []byte(`"aragorn"`)))
+}
+
+func TestFunc_NilError(t *testing.T) {
+	t.Parallel()
+	isTrue := func(v any) bool { return v == true }
+	isTrueCtx := func(_ context.Context, v any) (bool, error) { return v == true, nil }
+	for _, f := range []func(){
+		func() { valdo.Func(isTrue, nil, nil) },
+		func() { valdo.Check(isTrue, nil, nil) },
+		func() { valdo.FuncContext(isTrueCtx, nil, nil) },
+		func() { valdo.CheckContext(isTrueCtx, nil, nil) },
+	} {
+		func() {
+			defer func() {
+				isEq(recover(), any("the error must not be nil"))
+			}()
+			f()
+		}()
+	}
+}
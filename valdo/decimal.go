@@ -0,0 +1,358 @@
+package valdo
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+
+	"github.com/orsinium-labs/jsony"
+)
+
+const (
+	decimalPattern = `^-?(0|[1-9][0-9]*)(\.[0-9]+)?$`
+	bigIntPattern  = `^-?(0|[1-9][0-9]*)$`
+)
+
+var (
+	decimalRex = regexp.MustCompile(decimalPattern)
+	bigIntRex  = regexp.MustCompile(bigIntPattern)
+)
+
+// DecimalType is constructed by [Decimal] or [BigInt].
+type DecimalType struct {
+	cs      []Constraint[*big.Rat]
+	integer bool
+	numbers bool
+}
+
+// Decimal maps to "string" in JSON and [big.Rat] in Go.
+//
+// It accepts strings with a decimal number, like "12.50", and checks the constraints,
+// like [DecimalMin] or [Scale], using exact arithmetic.
+// Exponents, leading zeros, and leading plus signs are not allowed.
+//
+// Use it for money amounts and other values that must not lose precision
+// when decoded as float64.
+func Decimal(cs ...Constraint[*big.Rat]) DecimalType {
+	return DecimalType{}.Constrain(cs...)
+}
+
+// BigInt is the same as [Decimal] but accepts only arbitrary-precision integers, like "9007199254740993".
+func BigInt(cs ...Constraint[*big.Rat]) DecimalType {
+	return DecimalType{integer: true}.Constrain(cs...)
+}
+
+// Constrain adds constraints to the decimal, like [DecimalMin].
+func (d DecimalType) Constrain(cs ...Constraint[*big.Rat]) DecimalType {
+	d.cs = append(d.cs, cs...)
+	return d
+}
+
+// AllowNumbers makes the decimal accept JSON numbers in addition to strings.
+//
+// Numbers are decoded as float64 and so they are checked
+// using the shortest decimal representation of the float.
+// Use [json.Number] as the Go type to unmarshal both strings and numbers.
+//
+// When validating Go values, [json.Number] is checked as written,
+// so it must be in the same format as the strings, without an exponent.
+// Integers (int, int64, [jsony.Int]) are accepted as well.
+func (d DecimalType) AllowNumbers() DecimalType {
+	d.numbers = true
+	return d
+}
+
+// Validate implements [Validator].
+func (d DecimalType) Validate(raw any) Error {
+	return d.ValidateContext(context.Background(), raw)
+}
+
+// ValidateContext implements [ContextValidator].
+func (d DecimalType) ValidateContext(ctx context.Context, raw any) Error {
+	val, fErr := d.parse(raw)
+	if fErr != nil {
+		return fErr
+	}
+	res := Errors{}
+	for _, c := range d.cs {
+		res.Add(c.validate(ctx, val))
+	}
+	return res.Flatten()
+}
+
+// Schema implements [Validator].
+func (d DecimalType) Schema() jsony.Object {
+	var typ jsony.Encoder = jsony.String("string")
+	format := "decimal"
+	pattern := decimalPattern
+	if d.integer {
+		format = "bigint"
+		pattern = bigIntPattern
+	}
+	if d.numbers {
+		num := "number"
+		if d.integer {
+			num = "integer"
+		}
+		typ = jsony.Array[jsony.String]{"string", jsony.String(num)}
+	}
+	res := jsony.Object{
+		jsony.Field{K: "type", V: typ},
+		jsony.Field{K: "format", V: jsony.String(format)},
+		jsony.Field{K: "pattern", V: jsony.String(pattern)},
+	}
+	if d.numbers {
+		res = appendConstraints(res, numberConstraints(d.cs))
+	}
+	return appendConstraints(res, d.cs)
+}
+
+// numberConstraints returns schema-only constraints with JSON Schema keywords
+// for the decimal constraints, like "minimum" for "x-minimum" of [DecimalMin].
+//
+// The keywords apply only to JSON numbers, so they are used when [DecimalType.AllowNumbers] is set.
+func numberConstraints(cs []Constraint[*big.Rat]) []Constraint[*big.Rat] {
+	res := make([]Constraint[*big.Rat], 0, len(cs))
+	for _, c := range cs {
+		fields := jsony.Object{}
+		for _, f := range c.fields {
+			switch f.K {
+			case "x-minimum":
+				f.K = "minimum"
+			case "x-maximum":
+				f.K = "maximum"
+			case "x-multipleOf":
+				f.K = "multipleOf"
+			default:
+				continue
+			}
+			fields = append(fields, f)
+		}
+		if len(fields) > 0 {
+			res = append(res, Constraint[*big.Rat]{fields: fields})
+		}
+	}
+	return res
+}
+
+func (d DecimalType) parse(raw any) (*big.Rat, Error) {
+	var s string
+	switch val := raw.(type) {
+	case string:
+		s = val
+	case *string:
+		s = *val
+	case jsony.String:
+		s = string(val)
+	case *jsony.String:
+		s = string(*val)
+	case float64:
+		if !d.numbers {
+			return nil, ErrType{Got: "number", Expected: "string"}
+		}
+		if math.IsInf(val, 0) || math.IsNaN(val) {
+			return nil, d.formatErr()
+		}
+		s = strconv.FormatFloat(val, 'f', -1, 64)
+	case json.Number:
+		if !d.numbers {
+			return nil, ErrType{Got: "number", Expected: "string"}
+		}
+		s = string(val)
+	case *json.Number:
+		if !d.numbers {
+			return nil, ErrType{Got: "number", Expected: "string"}
+		}
+		s = string(*val)
+	default:
+		i, ok := intText(raw)
+		if !ok {
+			return nil, ErrType{Got: getTypeName(raw), Expected: "string"}
+		}
+		if !d.numbers {
+			return nil, ErrType{Got: "integer", Expected: "string"}
+		}
+		s = i
+	}
+	rex := decimalRex
+	if d.integer {
+		rex = bigIntRex
+	}
+	if !rex.MatchString(s) {
+		return nil, d.formatErr()
+	}
+	r, _ := new(big.Rat).SetString(s)
+	return r, nil
+}
+
+// intText formats the Go integer in base 10.
+func intText(raw any) (string, bool) {
+	switch val := raw.(type) {
+	case int:
+		return strconv.Itoa(val), true
+	case *int:
+		return strconv.Itoa(*val), true
+	case int64:
+		return strconv.FormatInt(val, 10), true
+	case *int64:
+		return strconv.FormatInt(*val, 10), true
+	case jsony.Int:
+		return strconv.Itoa(int(val)), true
+	case *jsony.Int:
+		return strconv.Itoa(int(*val)), true
+	default:
+		return "", false
+	}
+}
+
+func (d DecimalType) formatErr() Error {
+	if d.integer {
+		return ErrBigInt{}
+	}
+	return ErrDecimal{}
+}
+
+// mustParseDecimal parses the decimal passed into a constraint.
+func mustParseDecimal(s string) *big.Rat {
+	if !decimalRex.MatchString(s) {
+		panic("invalid decimal: " + s)
+	}
+	r, _ := new(big.Rat).SetString(s)
+	return r
+}
+
+// DecimalMin requires the [Decimal] to be greater than or equal to the given decimal.
+//
+// JSON Schema "minimum" applies only to numbers, so "x-minimum" extension keyword is used.
+// If [DecimalType.AllowNumbers] is set, "minimum" is added as well.
+//
+// Panics if the argument is not a valid decimal.
+func DecimalMin(s string) Constraint[*big.Rat] {
+	v := mustParseDecimal(s)
+	c := func(f *big.Rat) Error {
+		if f.Cmp(v) >= 0 {
+			return nil
+		}
+		return ErrMin{Value: json.Number(s)}
+	}
+	return Constraint[*big.Rat]{
+		check:  c,
+		fields: jsony.Object{{K: "x-minimum", V: RawJSON(s)}},
+	}
+}
+
+// DecimalMax requires the [Decimal] to be less than or equal to the given decimal.
+//
+// JSON Schema "maximum" applies only to numbers, so "x-maximum" extension keyword is used.
+// If [DecimalType.AllowNumbers] is set, "maximum" is added as well.
+//
+// Panics if the argument is not a valid decimal.
+func DecimalMax(s string) Constraint[*big.Rat] {
+	v := mustParseDecimal(s)
+	c := func(f *big.Rat) Error {
+		if f.Cmp(v) <= 0 {
+			return nil
+		}
+		return ErrMax{Value: json.Number(s)}
+	}
+	return Constraint[*big.Rat]{
+		check:  c,
+		fields: jsony.Object{{K: "x-maximum", V: RawJSON(s)}},
+	}
+}
+
+// DecimalMultipleOf requires the [Decimal] to be a multiple of the given decimal.
+//
+// For example, "0.05" allows only amounts rounded to 5 cents.
+//
+// JSON Schema "multipleOf" applies only to numbers, so "x-multipleOf" extension keyword is used.
+// If [DecimalType.AllowNumbers] is set, "multipleOf" is added as well.
+//
+// Panics if the argument is not a valid positive decimal.
+func DecimalMultipleOf(s string) Constraint[*big.Rat] {
+	v := mustParseDecimal(s)
+	if v.Sign() <= 0 {
+		panic("the value must be positive")
+	}
+	c := func(f *big.Rat) Error {
+		q := new(big.Rat).Quo(f, v)
+		if q.IsInt() {
+			return nil
+		}
+		return ErrMultipleOf{Value: json.Number(s)}
+	}
+	return Constraint[*big.Rat]{
+		check:  c,
+		fields: jsony.Object{{K: "x-multipleOf", V: RawJSON(s)}},
+	}
+}
+
+// Precision limits the total number of digits of the [Decimal],
+// before and after the decimal point.
+//
+// The zero before the decimal point and trailing zeros after it are not counted,
+// so "0.50" has the precision of 1, "0.05" has the precision of 2,
+// and "12.50" has the precision of 3.
+//
+// There is no JSON Schema keyword for it, so "x-precision" extension keyword is used.
+func Precision(max int) Constraint[*big.Rat] {
+	c := func(f *big.Rat) Error {
+		if decimalPrecision(f) <= max {
+			return nil
+		}
+		return ErrPrecision{Value: max}
+	}
+	return Constraint[*big.Rat]{
+		check:  c,
+		fields: jsony.Object{{K: "x-precision", V: jsony.Int(max)}},
+	}
+}
+
+// Scale limits the number of digits after the decimal point of the [Decimal].
+//
+// Trailing zeros are not counted, so "12.50" has the scale of 1.
+//
+// There is no JSON Schema keyword for it, so "x-scale" extension keyword is used.
+func Scale(max int) Constraint[*big.Rat] {
+	c := func(f *big.Rat) Error {
+		if decimalScale(f) <= max {
+			return nil
+		}
+		return ErrScale{Value: max}
+	}
+	return Constraint[*big.Rat]{
+		check:  c,
+		fields: jsony.Object{{K: "x-scale", V: jsony.Int(max)}},
+	}
+}
+
+// decimalScale returns the number of digits after the decimal point
+// needed to write the decimal number.
+func decimalScale(r *big.Rat) int {
+	den := r.Denom()
+	scale := 0
+	p := big.NewInt(1)
+	m := new(big.Int)
+	ten := big.NewInt(10)
+	for m.Mod(p, den).Sign() != 0 {
+		p.Mul(p, ten)
+		scale++
+	}
+	return scale
+}
+
+// decimalPrecision returns the number of significant digits of the decimal number.
+func decimalPrecision(r *big.Rat) int {
+	q := new(big.Int).Quo(r.Num(), r.Denom())
+	digits := 0
+	if q.Sign() != 0 {
+		digits = len(q.Text(10))
+		if q.Sign() < 0 {
+			digits--
+		}
+	}
+	return max(digits+decimalScale(r), 1)
+}
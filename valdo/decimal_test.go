@@ -0,0 +1,139 @@
+package valdo_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/orsinium-labs/jsony"
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestDecimal(t *testing.T) {
+	t.Parallel()
+	val := valdo.Decimal()
+	noErr(valdo.Validate(val, []byte(`"12.50"`)))
+	noErr(valdo.Validate(val, []byte(`"-0.001"`)))
+	noErr(valdo.Validate(val, []byte(`"123456789012345678901234567890.123456789"`)))
+	isErr[valdo.ErrDecimal](valdo.Validate(val, []byte(`"1e5"`)))
+	isErr[valdo.ErrDecimal](valdo.Validate(val, []byte(`"012"`)))
+	isErr[valdo.ErrDecimal](valdo.Validate(val, []byte(`"1."`)))
+	isErr[valdo.ErrDecimal](valdo.Validate(val, []byte(`"+1"`)))
+	isErr[valdo.ErrDecimal](valdo.Validate(val, []byte(`""`)))
+	isErr[valdo.ErrType](valdo.Validate(val, []byte(`12.5`)))
+	isErr[valdo.ErrType](valdo.Validate(val, []byte(`null`)))
+
+	val = val.AllowNumbers()
+	noErr(valdo.Validate(val, []byte(`12.5`)))
+	noErr(valdo.Validate(val, []byte(`"12.5"`)))
+	isErr[valdo.ErrType](valdo.Validate(val, []byte(`true`)))
+}
+
+func TestBigInt(t *testing.T) {
+	t.Parallel()
+	val := valdo.BigInt(valdo.DecimalMax("9007199254740993"))
+	noErr(valdo.Validate(val, []byte(`"9007199254740993"`)))
+	noErr(valdo.Validate(val, []byte(`"-9007199254740994"`)))
+	isErr[valdo.ErrMax](valdo.Validate(val, []byte(`"9007199254740994"`)))
+	isErr[valdo.ErrBigInt](valdo.Validate(val, []byte(`"1.5"`)))
+	isErr[valdo.ErrType](valdo.Validate(val, []byte(`1`)))
+
+	val = val.AllowNumbers()
+	noErr(valdo.Validate(val, []byte(`1`)))
+	isErr[valdo.ErrBigInt](valdo.Validate(val, []byte(`1.5`)))
+}
+
+func TestDecimal_GoValues(t *testing.T) {
+	t.Parallel()
+	val := valdo.Decimal(valdo.DecimalMax("100"))
+	noErr(val.Validate("12.5"))
+	err := val.Validate(json.Number("12.5"))
+	isErr[valdo.ErrType](err)
+	isEq(err.(valdo.ErrType).Got, "number")
+	err = val.Validate(int64(12))
+	isErr[valdo.ErrType](err)
+	isEq(err.(valdo.ErrType).Got, "integer")
+
+	val = val.AllowNumbers()
+	n := json.Number("12.5")
+	i, i64, ji := 12, int64(12), jsony.Int(12)
+	for _, v := range []any{n, &n, i, &i, i64, &i64, ji, &ji} {
+		noErr(val.Validate(v))
+	}
+	isErr[valdo.ErrMax](val.Validate(json.Number("100.5")))
+	isErr[valdo.ErrMax](val.Validate(int64(101)))
+	isErr[valdo.ErrMax](val.Validate(jsony.Int(101)))
+	isErr[valdo.ErrDecimal](val.Validate(json.Number("1e2")))
+	isErr[valdo.ErrBigInt](valdo.BigInt().AllowNumbers().Validate(json.Number("1.5")))
+	isErr[valdo.ErrType](val.Validate(true))
+}
+
+func TestDecimal_Constraints(t *testing.T) {
+	t.Parallel()
+	val := valdo.Decimal(
+		valdo.DecimalMin("0.01"),
+		valdo.DecimalMax("1000"),
+		valdo.DecimalMultipleOf("0.05"),
+	)
+	noErr(valdo.Validate(val, []byte(`"0.05"`)))
+	noErr(valdo.Validate(val, []byte(`"999.95"`)))
+	noErr(valdo.Validate(val, []byte(`"1000.00"`)))
+	isErr[valdo.ErrMin](valdo.Validate(val, []byte(`"0"`)))
+	isErr[valdo.ErrMax](valdo.Validate(val, []byte(`"1000.05"`)))
+	isErr[valdo.ErrMultipleOf](valdo.Validate(val, []byte(`"0.11"`)))
+
+	err := valdo.Validate(val, []byte(`"0.001"`))
+	isEq(err.Error(), "must be greater than or equal to 0.01; must be a multiple of 0.05")
+	err = valdo.Validate(valdo.DefaultLocales.Wrap("de", val), []byte(`"0"`))
+	isEq(err.Error(), "Muss größer oder gleich 0,01 sein")
+	isEq(string(jsony.EncodeBytes(valdo.ErrorJSON(valdo.ErrMin{Value: json.Number("0.01")}))),
+		`{"code":"min","message":"must be greater than or equal to 0.01","params":{"value":0.01}}`)
+}
+
+func TestPrecision_Scale(t *testing.T) {
+	t.Parallel()
+	val := valdo.Decimal(valdo.Precision(5), valdo.Scale(2))
+	noErr(valdo.Validate(val, []byte(`"123.45"`)))
+	noErr(valdo.Validate(val, []byte(`"-123.45"`)))
+	noErr(valdo.Validate(val, []byte(`"12345"`)))
+	noErr(valdo.Validate(val, []byte(`"0.05"`)))
+	noErr(valdo.Validate(val, []byte(`"0"`)))
+	noErr(valdo.Validate(val, []byte(`"12.5000"`)))
+	isErr[valdo.ErrPrecision](valdo.Validate(val, []byte(`"123456"`)))
+	isErr[valdo.ErrPrecision](valdo.Validate(val, []byte(`"1234.56"`)))
+	isErr[valdo.ErrScale](valdo.Validate(val, []byte(`"1.234"`)))
+
+	err := valdo.Validate(val, []byte(`"1.234"`))
+	isEq(err.Error(), "must have at most 2 digits after the decimal point")
+	err = valdo.Validate(valdo.Decimal(valdo.Precision(1)), []byte(`"12"`))
+	isEq(err.Error(), "must have at most 1 digit")
+}
+
+func TestDecimal_Check(t *testing.T) {
+	t.Parallel()
+	positive := valdo.Check(func(r *big.Rat) bool { return r.Sign() > 0 }, valdo.ErrExclMin{Value: 0}, nil)
+	val := valdo.Decimal(positive)
+	noErr(valdo.Validate(val, []byte(`"0.000000000000000000001"`)))
+	isErr[valdo.ErrExclMin](valdo.Validate(val, []byte(`"0.000"`)))
+}
+
+func TestDecimal_Schema(t *testing.T) {
+	t.Parallel()
+	val := valdo.Decimal(valdo.DecimalMin("0.01"), valdo.Scale(2))
+	isEq(string(valdo.Schema(val)), `{"type":"string","format":"decimal","pattern":"^-?(0|[1-9][0-9]*)(\\.[0-9]+)?$","x-minimum":0.01,"x-scale":2}`)
+	val = val.AllowNumbers().Constrain(valdo.DecimalMultipleOf("0.05"))
+	isEq(string(valdo.Schema(val)), `{"type":["string","number"],"format":"decimal","pattern":"^-?(0|[1-9][0-9]*)(\\.[0-9]+)?$","minimum":0.01,"multipleOf":0.05,"x-minimum":0.01,"x-scale":2,"x-multipleOf":0.05}`)
+	val = valdo.BigInt().AllowNumbers()
+	isEq(string(valdo.Schema(val)), `{"type":["string","integer"],"format":"bigint","pattern":"^-?(0|[1-9][0-9]*)$"}`)
+}
+
+func TestDecimal_Unmarshal(t *testing.T) {
+	t.Parallel()
+	type payment struct {
+		Amount *big.Rat `json:"amount"`
+	}
+	val := valdo.Object(valdo.P("amount", valdo.Decimal(valdo.Scale(2))))
+	p, err := valdo.Unmarshal[payment](val, []byte(`{"amount": "12.50"}`))
+	noErr(err)
+	isEq(p.Amount.FloatString(2), "12.50")
+}
@@ -0,0 +1,88 @@
+package valdo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/orsinium-labs/jsony"
+)
+
+type defaultsVal struct {
+	v Validator
+}
+
+// WithDefaults makes [Unmarshal] fill in absent optional properties
+// with [Meta] Default values before decoding the input into the target type.
+//
+// Defaults are applied recursively, including properties of objects inside of arrays.
+// WithDefaults can also wrap only a part of the validator, like a single nested object,
+// to apply the defaults only there.
+// Validation is not affected: the input is validated as is.
+//
+// All defaults are validated using the validator they are attached to.
+// Panics if any of them is invalid.
+//
+//	user := valdo.WithDefaults(valdo.Object(
+//		valdo.P("name", valdo.String()),
+//		valdo.P("admin", valdo.Meta{Validator: valdo.Bool(), Default: jsony.False}).Optional(),
+//	))
+func WithDefaults(v Validator) Validator {
+	walk(v, "", func(loc string, v Validator) {
+		m, ok := v.(Meta)
+		if !ok || m.Default == nil {
+			return
+		}
+		err := checkEncoded(m.Validator, m.Default)
+		if err != nil {
+			panic(fmt.Sprintf("invalid default at #%s: %v", loc, err))
+		}
+	})
+	return defaultsVal{v: v}
+}
+
+// Validate implements [Validator].
+func (d defaultsVal) Validate(data any) Error {
+	return d.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (d defaultsVal) ValidateContext(ctx context.Context, data any) Error {
+	return validateContext(ctx, d.v, data)
+}
+
+// Schema implements [Validator].
+func (d defaultsVal) Schema() jsony.Object {
+	return d.v.Schema()
+}
+
+// unwrap implements [wrapper].
+func (d defaultsVal) unwrap() Validator {
+	return d.v
+}
+
+// getDefault returns the decoded default value of the validator, if it has one.
+func getDefault(v Validator) (any, bool) {
+	for {
+		m, ok := v.(Meta)
+		if ok && m.Default != nil {
+			def, err := decodeNumbers(jsony.EncodeBytes(m.Default))
+			return def, err == nil
+		}
+		w, ok := v.(wrapper)
+		if !ok {
+			return nil, false
+		}
+		v = w.unwrap()
+	}
+}
+
+// decodeNumbers decodes JSON keeping numbers as [json.Number].
+func decodeNumbers(input []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.UseNumber()
+	var res any
+	err := dec.Decode(&res)
+	return res, err
+}
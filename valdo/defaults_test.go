@@ -0,0 +1,115 @@
+package valdo_test
+
+import (
+	"testing"
+
+	"github.com/orsinium-labs/jsony"
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+type settings struct {
+	Theme string `json:"theme"`
+	Size  int64  `json:"size"`
+}
+
+type user struct {
+	Name     string     `json:"name"`
+	Admin    bool       `json:"admin"`
+	Settings []settings `json:"settings"`
+}
+
+func TestWithDefaults(t *testing.T) {
+	t.Parallel()
+	theme := valdo.Meta{Validator: valdo.Enum("light", "dark"), Default: jsony.String("dark")}
+	size := valdo.Meta{Validator: valdo.Int(valdo.Min(1)), Default: jsony.Int(12)}
+	val := valdo.WithDefaults(valdo.Object(
+		valdo.P("name", valdo.Meta{Validator: valdo.String(), Default: jsony.String("anonymous")}),
+		valdo.P("admin", valdo.Meta{Validator: valdo.Bool(), Default: jsony.True}).Optional(),
+		valdo.P("settings", valdo.Array(valdo.Object(
+			valdo.P("theme", theme).Optional(),
+			valdo.P("size", size).Optional(),
+		))),
+	))
+
+	u, err := valdo.Unmarshal[user](val, []byte(`{"name": "aragorn", "settings": [{}, {"theme": "light", "size": 9007199254740993}]}`))
+	noErr(err)
+	isEq(u.Name, "aragorn")
+	isEq(u.Admin, true)
+	isEq(len(u.Settings), 2)
+	isEq(u.Settings[0], settings{Theme: "dark", Size: 12})
+	isEq(u.Settings[1], settings{Theme: "light", Size: 9007199254740993})
+
+	u, err = valdo.Unmarshal[user](val, []byte(`{"name": "aragorn", "admin": false, "settings": []}`))
+	noErr(err)
+	isEq(u.Admin, false)
+
+	// required properties are not filled in
+	_, err = valdo.Unmarshal[user](val, []byte(`{"settings": []}`))
+	isErr[valdo.ErrRequired](err)
+
+	// defaults are applied through wrappers
+	translated := valdo.DefaultLocales.Wrap("nl", val)
+	u, err = valdo.Unmarshal[user](translated, []byte(`{"name": "aragorn", "settings": [{}]}`))
+	noErr(err)
+	isEq(u.Admin, true)
+	isEq(u.Settings[0].Theme, "dark")
+
+	// without WithDefaults, defaults are only a part of the schema
+	u, err = valdo.Unmarshal[user](valdo.Object(
+		valdo.P("admin", valdo.Meta{Validator: valdo.Bool(), Default: jsony.True}).Optional(),
+	), []byte(`{}`))
+	noErr(err)
+	isEq(u.Admin, false)
+}
+
+func TestWithDefaults_Nullable(t *testing.T) {
+	t.Parallel()
+	val := valdo.WithDefaults(valdo.Object(
+		valdo.P("settings", valdo.Nullable(valdo.Object(
+			valdo.P("theme", valdo.Meta{Validator: valdo.String(), Default: jsony.String("dark")}).Optional(),
+		))),
+	))
+	type target struct {
+		Settings *settings `json:"settings"`
+	}
+	res, err := valdo.Unmarshal[target](val, []byte(`{"settings": {}}`))
+	noErr(err)
+	isEq(res.Settings.Theme, "dark")
+	res, err = valdo.Unmarshal[target](val, []byte(`{"settings": null}`))
+	noErr(err)
+	isEq(res.Settings, nil)
+}
+
+func TestWithDefaults_Nested(t *testing.T) {
+	t.Parallel()
+	type team struct {
+		Owner   user   `json:"owner"`
+		Members []user `json:"members"`
+	}
+	member := valdo.Object(
+		valdo.P("name", valdo.String()),
+		valdo.P("admin", valdo.Meta{Validator: valdo.Bool(), Default: jsony.True}).Optional(),
+	)
+	val := valdo.Object(
+		valdo.P("owner", member),
+		valdo.P("members", valdo.Array(valdo.WithDefaults(member))),
+	)
+	res, err := valdo.Unmarshal[team](val, []byte(`{"owner": {"name": "aragorn"}, "members": [{"name": "frodo"}]}`))
+	noErr(err)
+	isEq(res.Owner.Admin, false)
+	isEq(res.Members[0].Admin, true)
+
+	res, err = valdo.Unmarshal[team](valdo.DefaultLocales.Wrap("en", val), []byte(`{"owner": {"name": "aragorn"}, "members": [{"name": "frodo"}]}`))
+	noErr(err)
+	isEq(res.Members[0].Admin, true)
+}
+
+func TestWithDefaults_Invalid(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		isEq(recover(), any("invalid default at #/properties/size: must be greater than or equal to 1"))
+	}()
+	valdo.WithDefaults(valdo.Object(
+		valdo.P("size", valdo.Meta{Validator: valdo.Int(valdo.Min(1)), Default: jsony.Int(0)}).Optional(),
+	))
+}
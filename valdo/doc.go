@@ -11,8 +11,15 @@
 // of multiple types.
 //
 //   - Primitive types: [Bool], [Float64], [Int], [String], [Null], [Any].
+//   - Exact numbers encoded as strings: [Decimal], [BigInt]
+//   - Constants: [StringConst], [IntConst], [Float64Const], [BoolConst], [JSONConst]
+//   - Enumerations: [Enum], [EnumOf], [JSONEnum]
 //   - Collections: [Array], [Object], [Map]
-//   - Composition: [AllOf], [Not]
+//   - Composition: [AllOf], [AnyOf], [Not]
+//   - Unevaluated: [UnevaluatedProperties], [UnevaluatedItems]
+//
+// Objects can be derived from other objects using [ObjectType.Extend],
+// [ObjectType.Pick], [ObjectType.Omit], [ObjectType.Partial], and [ObjectType.Required].
 //
 // # Constraints
 //
@@ -20,9 +27,47 @@
 // as an argument of their constructor or as Constrain method.
 //
 //   - Numeric constraints: [ExclMax], [ExclMin], [Max], [Min], [MultipleOf]
-//   - String constraints: [MaxLen], [MinLen], [Pattern]
+//   - String constraints: [MaxLen], [MinLen], [MaxBytes],
+//     [MaxGraphemes], [MinGraphemes], [Pattern]
+//   - Decimal constraints: [DecimalMin], [DecimalMax], [DecimalMultipleOf],
+//     [Precision], [Scale]
 //   - Object constraints: [MaxProperties], [MinProperties], [PropertyNames]
-//   - Array constraints: [Contains], [MaxItems], [MinItems]
+//   - Cross-field constraints: [Less], [LessOrEqual], [EqualFields],
+//     [AtLeastOneOf], [ExactlyOneOf], [NoneOf]
+//   - Array constraints: [Contains], [MinContains], [MaxContains],
+//     [MaxItems], [MinItems], [UniqueItems], [UniqueBy]
+//
+// Custom checks can be added using [Check] for constraints and [Func] for validators.
+//
+// Checks that need a [context.Context], like looking up a value in the database,
+// can be added using [CheckContext], [FuncContext], [Exists], and [NotExists].
+// Such checks receive the context passed into [ValidateContext].
+// Slow checks of an object can run concurrently, see [ObjectType.Concurrent].
+//
+// Values marked as ReadOnly or WriteOnly in [Meta] are rejected
+// when validating a request or a response, see [WithMode].
+//
+// [Unmarshal] fills in absent optional properties with [Meta] defaults
+// inside of validators wrapped in [WithDefaults].
+//
+// [CheckExamples] reports examples and defaults that don't pass their own validators.
+//
+// Input can be cleaned before validation using [Sanitize] with transforms like
+// [TrimSpace], [ToLower], [CaseFold], [NormalizeNFC], [CollapseSpaces], and [DropUnknown].
+// [Clean] and [Unmarshal] return the cleaned data.
+//
+// Objects created with [ObjectType.StripUnknown] drop unknown properties
+// instead of rejecting them and report them as warnings.
+//
+// # Warnings
+//
+// Warnings are non-fatal problems that don't fail the validation.
+// They are collected if the context passed into [ValidateContext] is created by [WithWarnings].
+// Present values marked as Deprecated in [Meta] or [EnumOf] produce [ErrDeprecated],
+// and soft constraints created by [Warn] report their errors as warnings.
+// Warnings are translated by [Locale] the same way as errors.
+// [WarningHeader] formats them for the Warning HTTP header,
+// and [ErrorJSON] converts each of them into JSON for a response field.
 //
 // # Errors
 //
@@ -33,9 +78,17 @@
 //   - [ErrProperty]
 //   - [ErrIndex]
 //   - [ErrType]
+//   - [ErrDecimal]
+//   - [ErrBigInt]
 //   - [ErrRequired]
 //   - [ErrUnexpected]
+//   - [ErrUnexpectedItem]
+//   - [ErrConst]
+//   - [ErrEnum]
 //   - [ErrNot]
+//   - [ErrAnyOf]
+//   - [ErrReadOnly]
+//   - [ErrWriteOnly]
 //
 // Or one of the constraint errors:
 //
@@ -46,15 +99,43 @@
 //   - [ErrExclMax]
 //   - [ErrMinLen]
 //   - [ErrMaxLen]
+//   - [ErrMinGraphemes]
+//   - [ErrMaxGraphemes]
+//   - [ErrMaxBytes]
+//   - [ErrPrecision]
+//   - [ErrScale]
 //   - [ErrPattern]
 //   - [ErrContains]
+//   - [ErrMinContains]
+//   - [ErrMaxContains]
 //   - [ErrMinItems]
 //   - [ErrMaxItems]
+//   - [ErrUniqueItems]
 //   - [ErrPropertyNames]
 //   - [ErrMinProperties]
 //   - [ErrMaxProperties]
+//   - [ErrCheck]
+//   - [ErrNotFound]
+//   - [ErrExists]
+//   - [ErrLessField]
+//   - [ErrLessOrEqualField]
+//   - [ErrEqualField]
+//   - [ErrAtLeastOneOf]
+//   - [ErrExactlyOneOf]
+//   - [ErrMessage]
+//
+// Every built-in error implements [Coder] and [Parametrized], and [ErrorJSON]
+// converts an error tree into JSON for clients that render their own messages.
+//
+// The error of a specific validator or constraint can be replaced
+// with a custom message using [WithMessage] or [Constraint.Message].
 //
 // The errors can be translated using [Locale].
 // Multiple locales can be combined in a single registry
-// using [Locales].
+// using [Locales], and [Locales.Negotiate] selects the best one
+// for the languages listed in an Accept-Language header.
+// Words used in messages, like JSON type names, are translated using [Locale.SetTerm].
+// [Locale.Missing] and [Locale.MissingTerms] report errors and terms that a locale doesn't translate yet.
+// Translations can also be loaded from JSON, flat key-value, or gettext PO files
+// using [ReadLocales], with the error code used as the message key.
 package valdo
@@ -1,6 +1,17 @@
 package valdo
 
-import "github.com/orsinium-labs/jsony"
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/orsinium-labs/jsony"
+	"github.com/orsinium-labs/valdo/internal"
+)
 
 type enum struct {
 	values []string
@@ -8,9 +19,40 @@ type enum struct {
 
 // Enum requires the input value to be one of the given constants.
 //
+// The constants can be strings or numbers, including named types based on them.
+// Numbers are compared as float64, so 1 and 1.0 are equal.
+//
+//	valdo.Enum("red", "green", "blue")
+//	valdo.Enum(1, 2, 3)
+//
+// Use [JSONEnum] for null, booleans, arrays, and objects.
+//
 // https://json-schema.org/understanding-json-schema/reference/enum
-func Enum(vs ...string) Validator {
-	return enum{vs}
+func Enum[T ~string | internal.Number](vs ...T) Validator {
+	var zero T
+	if reflect.ValueOf(zero).Kind() == reflect.String {
+		values := make([]string, len(vs))
+		for i, v := range vs {
+			values[i] = reflect.ValueOf(v).String()
+		}
+		return enum{values}
+	}
+	values := make([]float64, len(vs))
+	for i, v := range vs {
+		values[i] = toFloat64(reflect.ValueOf(v))
+	}
+	return numEnum{values}
+}
+
+// toFloat64 converts the reflected number into float64.
+func toFloat64(v reflect.Value) float64 {
+	if v.CanInt() {
+		return float64(v.Int())
+	}
+	if v.CanUint() {
+		return float64(v.Uint())
+	}
+	return v.Float()
 }
 
 // Validate implements [Validator].
@@ -27,6 +69,11 @@ func (v enum) Validate(data any) Error {
 	return ErrEnum{Got: got, Expected: v.values}
 }
 
+// ValidateContext implements [ContextValidator].
+func (v enum) ValidateContext(ctx context.Context, data any) Error {
+	return v.Validate(data)
+}
+
 // Schema implements [Validator].
 func (v enum) Schema() jsony.Object {
 	values := make([]jsony.String, len(v.values))
@@ -37,3 +84,232 @@ func (v enum) Schema() jsony.Object {
 		jsony.Field{K: "enum", V: jsony.Array[jsony.String](values)},
 	}
 }
+
+type numEnum struct {
+	values []float64
+}
+
+// Validate implements [Validator].
+func (v numEnum) Validate(data any) Error {
+	got, err := float64Validator(data)
+	if err != nil {
+		return err
+	}
+	for _, exp := range v.values {
+		if got == exp {
+			return nil
+		}
+	}
+	return ErrEnum{Got: strconv.FormatFloat(got, 'g', -1, 64), Expected: v.values}
+}
+
+// ValidateContext implements [ContextValidator].
+func (v numEnum) ValidateContext(ctx context.Context, data any) Error {
+	return v.Validate(data)
+}
+
+// Schema implements [Validator].
+func (v numEnum) Schema() jsony.Object {
+	values := make([]jsony.Float64, len(v.values))
+	for i, val := range v.values {
+		values[i] = jsony.Float64(val)
+	}
+	return jsony.Object{
+		jsony.Field{K: "enum", V: jsony.Array[jsony.Float64](values)},
+	}
+}
+
+// RawJSON is a JSON value encoded as is.
+//
+// It can be passed into [JSONConst] and [JSONEnum]:
+//
+//	valdo.JSONConst(valdo.RawJSON(`{"x": 0, "y": 0}`))
+type RawJSON string
+
+// EncodeJSON implements [jsony.Encoder].
+func (r RawJSON) EncodeJSON(w *jsony.Bytes) {
+	w.Extend([]byte(r))
+}
+
+// jsonValue is a JSON value of [JSONConst] and [JSONEnum].
+type jsonValue struct {
+	// raw is the compact JSON encoding of the value.
+	raw RawJSON
+	// key is the canonical representation of the value, see [writeCanonical].
+	key string
+	// param is the value used in error messages:
+	// the decoded value for strings, numbers, and booleans, and [json.RawMessage] otherwise.
+	param any
+}
+
+// newJSONValue encodes and decodes the value.
+//
+// Panics if the value is not valid JSON.
+func newJSONValue(v jsony.Encoder) jsonValue {
+	var buf bytes.Buffer
+	err := json.Compact(&buf, jsony.EncodeBytes(v))
+	if err != nil {
+		panic("invalid JSON: " + err.Error())
+	}
+	var decoded any
+	err = json.Unmarshal(buf.Bytes(), &decoded)
+	if err != nil {
+		panic("invalid JSON: " + err.Error())
+	}
+	res := jsonValue{raw: RawJSON(buf.String()), key: canonicalKey(decoded)}
+	switch decoded.(type) {
+	case string, float64, bool:
+		res.param = decoded
+	default:
+		res.param = json.RawMessage(buf.Bytes())
+	}
+	return res
+}
+
+// canonicalKey returns the same string for all equal JSON values.
+func canonicalKey(v any) string {
+	var b strings.Builder
+	writeCanonical(&b, v)
+	return b.String()
+}
+
+type jsonEnum struct {
+	values []jsonValue
+}
+
+// JSONEnum requires the input value to be equal to one of the given JSON values.
+//
+// Values can be of any JSON type and are compared as JSON:
+// numbers are equal if they have the same value (1 and 1.0 are equal)
+// and objects are equal regardless of the order of keys.
+//
+// Panics if any of the values is not valid JSON.
+//
+//	valdo.JSONEnum(jsony.Null, jsony.Int(0), valdo.RawJSON(`[0, 0]`))
+//
+// https://json-schema.org/understanding-json-schema/reference/enum
+func JSONEnum(vs ...jsony.Encoder) Validator {
+	values := make([]jsonValue, len(vs))
+	for i, v := range vs {
+		values[i] = newJSONValue(v)
+	}
+	return jsonEnum{values}
+}
+
+// Validate implements [Validator].
+func (v jsonEnum) Validate(data any) Error {
+	key := canonicalKey(data)
+	for _, exp := range v.values {
+		if key == exp.key {
+			return nil
+		}
+	}
+	expected := make([]any, len(v.values))
+	for i, val := range v.values {
+		expected[i] = val.param
+	}
+	got, _ := json.Marshal(data)
+	return ErrEnum{Got: string(got), Expected: expected}
+}
+
+// ValidateContext implements [ContextValidator].
+func (v jsonEnum) ValidateContext(ctx context.Context, data any) Error {
+	return v.Validate(data)
+}
+
+// Schema implements [Validator].
+func (v jsonEnum) Schema() jsony.Object {
+	values := make([]RawJSON, len(v.values))
+	for i, val := range v.values {
+		values[i] = val.raw
+	}
+	return jsony.Object{
+		jsony.Field{K: "enum", V: jsony.Array[RawJSON](values)},
+	}
+}
+
+// EnumValue is a documented value of [EnumOf].
+type EnumValue struct {
+	Value       string
+	Title       string
+	Description string
+	// Deprecated values are accepted but reported as [ErrDeprecated] warnings.
+	Deprecated bool
+}
+
+type describedEnum struct {
+	enum
+	docs []EnumValue
+}
+
+// EnumOf is the same as [Enum] but each value has its own documentation.
+//
+// The schema has "enum" with all values for tools that don't support "oneOf",
+// "oneOf" with "const" for each value, and "x-enum-descriptions"
+// with the list of descriptions in the same order, used by OpenAPI code generators.
+//
+// Panics if there are no values or if a value is listed more than once.
+//
+//	status := valdo.EnumOf(
+//		valdo.EnumValue{Value: "pending", Description: "Waiting for the payment"},
+//		valdo.EnumValue{Value: "paid", Description: "The payment is received"},
+//		valdo.EnumValue{Value: "refunded", Deprecated: true},
+//	)
+func EnumOf(vs ...EnumValue) Validator {
+	if len(vs) == 0 {
+		panic("at least one value is required")
+	}
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		if slices.Contains(values[:i], v.Value) {
+			panic("duplicate value: " + v.Value)
+		}
+		values[i] = v.Value
+	}
+	return describedEnum{enum: enum{values}, docs: vs}
+}
+
+// ValidateContext implements [ContextValidator].
+func (v describedEnum) ValidateContext(ctx context.Context, data any) Error {
+	err := v.Validate(data)
+	if err != nil {
+		return err
+	}
+	got, _ := stringValidator(data)
+	for _, doc := range v.docs {
+		if doc.Value == got && doc.Deprecated {
+			addWarning(ctx, ErrDeprecated{})
+			break
+		}
+	}
+	return nil
+}
+
+// Schema implements [Validator].
+func (v describedEnum) Schema() jsony.Object {
+	oneOf := make(jsony.Array[jsony.Object], len(v.docs))
+	descriptions := make(jsony.Array[jsony.String], len(v.docs))
+	described := false
+	for i, doc := range v.docs {
+		s := jsony.Object{jsony.Field{K: "const", V: jsony.String(doc.Value)}}
+		if doc.Title != "" {
+			s = append(s, jsony.Field{K: "title", V: jsony.String(doc.Title)})
+		}
+		if doc.Description != "" {
+			s = append(s, jsony.Field{K: "description", V: jsony.String(doc.Description)})
+			described = true
+		}
+		if doc.Deprecated {
+			s = append(s, jsony.Field{K: "deprecated", V: jsony.True})
+		}
+		oneOf[i] = s
+		descriptions[i] = jsony.String(doc.Description)
+	}
+	res := jsony.Object{jsony.Field{K: "type", V: jsony.SafeString("string")}}
+	res = append(res, v.enum.Schema()...)
+	res = append(res, jsony.Field{K: "oneOf", V: oneOf})
+	if described {
+		res = append(res, jsony.Field{K: "x-enum-descriptions", V: descriptions})
+	}
+	return res
+}
@@ -0,0 +1,157 @@
+package valdo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/orsinium-labs/jsony"
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestEnum_String(t *testing.T) {
+	t.Parallel()
+	val := valdo.Enum("red", "green")
+	noErr(valdo.Validate(val, []byte(`"red"`)))
+	err := valdo.Validate(val, []byte(`"blue"`))
+	isErr[valdo.ErrEnum](err)
+	isEq(err.(valdo.ErrEnum).Got, "blue")
+	isEq(err.Error(), "expected the value to be one of: red, green")
+	isErr[valdo.ErrType](valdo.Validate(val, []byte(`1`)))
+	isEq(string(valdo.Schema(val)), `{"enum":["red","green"]}`)
+}
+
+func TestEnum_Named(t *testing.T) {
+	t.Parallel()
+	type color string
+	colors := []color{"red", "green"}
+	val := valdo.Enum(colors...)
+	noErr(valdo.Validate(val, []byte(`"red"`)))
+	isErr[valdo.ErrEnum](valdo.Validate(val, []byte(`"blue"`)))
+	isEq(string(valdo.Schema(val)), `{"enum":["red","green"]}`)
+
+	type level uint8
+	val = valdo.Enum[level](1, 2)
+	noErr(valdo.Validate(val, []byte(`2`)))
+	isErr[valdo.ErrEnum](valdo.Validate(val, []byte(`3`)))
+	isEq(string(valdo.Schema(val)), `{"enum":[1,2]}`)
+}
+
+func TestEnum_Number(t *testing.T) {
+	t.Parallel()
+	val := valdo.Enum(1, 2, 3)
+	noErr(valdo.Validate(val, []byte(`1`)))
+	noErr(valdo.Validate(val, []byte(`3.0`)))
+	isErr[valdo.ErrEnum](valdo.Validate(val, []byte(`4`)))
+	isErr[valdo.ErrEnum](valdo.Validate(val, []byte(`1.5`)))
+	isErr[valdo.ErrType](valdo.Validate(val, []byte(`"1"`)))
+	isEq(string(valdo.Schema(val)), `{"enum":[1,2,3]}`)
+
+	val = valdo.Enum(0.5, 1)
+	noErr(valdo.Validate(val, []byte(`0.5`)))
+	err := valdo.Validate(val, []byte(`2.0`))
+	isEq(err.(valdo.ErrEnum).Got, "2")
+	isEq(err.Error(), "expected the value to be one of: 0.5, 1")
+	isEq(string(jsony.EncodeBytes(valdo.ErrorJSON(err))),
+		`{"code":"enum","message":"expected the value to be one of: 0.5, 1","params":{"expected":[0.5,1]}}`)
+	err = valdo.Validate(valdo.DefaultLocales.Wrap("de", val), []byte(`2`))
+	isEq(err.Error(), "erwartet, dass der Wert einer der folgenden ist: 0,5, 1")
+	isEq(string(valdo.Schema(val)), `{"enum":[0.5,1]}`)
+}
+
+func TestJSONEnum(t *testing.T) {
+	t.Parallel()
+	val := valdo.JSONEnum(jsony.Null, jsony.Int(0), jsony.String("auto"), valdo.RawJSON(`[1, {"a": 2}]`))
+	noErr(valdo.Validate(val, []byte(`null`)))
+	noErr(valdo.Validate(val, []byte(`0.0`)))
+	noErr(valdo.Validate(val, []byte(`"auto"`)))
+	noErr(valdo.Validate(val, []byte(`[1, {"a": 2.0}]`)))
+	isErr[valdo.ErrEnum](valdo.Validate(val, []byte(`false`)))
+	isErr[valdo.ErrEnum](valdo.Validate(val, []byte(`"0"`)))
+	isErr[valdo.ErrEnum](valdo.Validate(val, []byte(`[{"a": 2}, 1]`)))
+
+	err := valdo.Validate(val, []byte(`1`))
+	isEq(err.Error(), `expected the value to be one of: null, 0, "auto", [1,{"a":2}]`)
+	isEq(string(jsony.EncodeBytes(valdo.ErrorJSON(err))),
+		`{"code":"enum","message":"expected the value to be one of: null, 0, \"auto\", [1,{\"a\":2}]","params":{"expected":[null,0,"auto",[1,{"a":2}]]}}`)
+	isEq(string(valdo.Schema(val)), `{"enum":[null,0,"auto",[1,{"a":2}]]}`)
+
+	val = valdo.JSONEnum(jsony.Null, jsony.String("null"), jsony.Float64(0.5))
+	err = valdo.Validate(val, []byte(`1`))
+	isEq(err.(valdo.ErrEnum).Got, "1")
+	isEq(err.Error(), `expected the value to be one of: null, "null", 0.5`)
+	isEq(string(jsony.EncodeBytes(valdo.ErrorJSON(err))),
+		`{"code":"enum","message":"expected the value to be one of: null, \"null\", 0.5","params":{"expected":[null,"null",0.5]}}`)
+	err = valdo.Validate(valdo.DefaultLocales.Wrap("de", val), []byte(`1`))
+	isEq(err.Error(), `erwartet, dass der Wert einer der folgenden ist: null, "null", 0,5`)
+}
+
+func TestEnumOf(t *testing.T) {
+	t.Parallel()
+	val := valdo.EnumOf(
+		valdo.EnumValue{Value: "pending", Title: "Pending", Description: "Waiting for the payment"},
+		valdo.EnumValue{Value: "paid"},
+		valdo.EnumValue{Value: "refunded", Description: "Use cancelled instead", Deprecated: true},
+	)
+	noErr(valdo.Validate(val, []byte(`"pending"`)))
+	noErr(valdo.Validate(val, []byte(`"refunded"`)))
+	isErr[valdo.ErrEnum](valdo.Validate(val, []byte(`"cancelled"`)))
+	isErr[valdo.ErrType](valdo.Validate(val, []byte(`1`)))
+	err := valdo.Validate(val, []byte(`"cancelled"`))
+	isEq(err.Error(), "expected the value to be one of: pending, paid, refunded")
+
+	obj := valdo.Object(valdo.P("status", val))
+	ctx, warnings := valdo.WithWarnings(context.Background())
+	noErr(valdo.ValidateContext(ctx, obj, []byte(`{"status": "paid"}`)))
+	isEq(len(warnings.Errors()), 0)
+	noErr(valdo.ValidateContext(ctx, obj, []byte(`{"status": "refunded"}`)))
+	isEq(len(warnings.Errors()), 1)
+	isEq(warnings.Errors()[0].Error(), "status: is deprecated")
+
+	ctx, warnings = valdo.WithWarnings(context.Background())
+	isErr[valdo.ErrProperty](valdo.ValidateContext(ctx, obj, []byte(`{"status": "cancelled"}`)))
+	isEq(len(warnings.Errors()), 0)
+}
+
+func TestEnumOf_Schema(t *testing.T) {
+	t.Parallel()
+	val := valdo.EnumOf(
+		valdo.EnumValue{Value: "pending", Title: "Pending", Description: "Waiting for the payment"},
+		valdo.EnumValue{Value: "refunded", Deprecated: true},
+	)
+	isEq(string(valdo.Schema(val)), `{"type":"string","enum":["pending","refunded"],"oneOf":[`+
+		`{"const":"pending","title":"Pending","description":"Waiting for the payment"},`+
+		`{"const":"refunded","deprecated":true}],`+
+		`"x-enum-descriptions":["Waiting for the payment",""]}`)
+
+	val = valdo.EnumOf(valdo.EnumValue{Value: "a"}, valdo.EnumValue{Value: "b"})
+	isEq(string(valdo.Schema(val)), `{"type":"string","enum":["a","b"],"oneOf":[{"const":"a"},{"const":"b"}]}`)
+}
+
+func TestEnumOf_Invalid(t *testing.T) {
+	t.Parallel()
+	func() {
+		defer func() {
+			isEq(recover(), any("at least one value is required"))
+		}()
+		valdo.EnumOf()
+	}()
+	defer func() {
+		isEq(recover(), any("duplicate value: a"))
+	}()
+	valdo.EnumOf(valdo.EnumValue{Value: "a"}, valdo.EnumValue{Value: "b"}, valdo.EnumValue{Value: "a"})
+}
+
+func TestEnumOf_AnyOf(t *testing.T) {
+	t.Parallel()
+	status := valdo.EnumOf(valdo.EnumValue{Value: "x", Deprecated: true}, valdo.EnumValue{Value: "y"})
+	val := valdo.AnyOf(
+		valdo.Object(valdo.P("status", status), valdo.P("kind", valdo.StringConst("a"))),
+		valdo.Object(valdo.P("status", valdo.String()), valdo.P("kind", valdo.StringConst("b"))),
+	)
+	ctx, warnings := valdo.WithWarnings(context.Background())
+	noErr(valdo.ValidateContext(ctx, val, []byte(`{"status": "x", "kind": "b"}`)))
+	isEq(len(warnings.Errors()), 0)
+	noErr(valdo.ValidateContext(ctx, val, []byte(`{"status": "x", "kind": "a"}`)))
+	isEq(len(warnings.Errors()), 1)
+	isEq(warnings.Errors()[0].Error(), "status: is deprecated")
+}
@@ -1,8 +1,13 @@
 package valdo
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
+
+	"github.com/orsinium-labs/jsony"
 )
 
 type Error interface {
@@ -13,6 +18,39 @@ type Error interface {
 	SetFormat(f string) Error
 }
 
+// Coder is an [Error] with a stable machine-readable identifier of its type.
+//
+// All built-in errors implement it. The code is used by [ErrorJSON] and as
+// the message key in translation files, see [ReadLocaleJSON]. Custom errors
+// should implement it to be translatable using translation files.
+type Coder interface {
+	Error
+	// Code returns the identifier of the error type, like "min_len" for [ErrMinLen].
+	Code() string
+}
+
+// Parametrized is an [Error] that exposes the values substituted into its message,
+// so that clients can render their own messages.
+//
+// All built-in errors implement it, the ones without parameters return nil.
+// The parameters are used by [ErrorJSON].
+type Parametrized interface {
+	Error
+	// Params returns the error parameters by their names in the message.
+	//
+	// Nested errors are returned as [Error] and lists of errors as []Error.
+	Params() map[string]any
+}
+
+// errorCode returns the code of the error or an empty string if it doesn't have one.
+func errorCode(e Error) string {
+	c, ok := e.(Coder)
+	if !ok {
+		return ""
+	}
+	return c.Code()
+}
+
 // ErrorWrapper is an [Error] that wraps another error.
 type ErrorWrapper interface {
 	Error
@@ -31,21 +69,98 @@ var (
 	_ ErrorWrapper = ErrContains{}
 	_ ErrorWrapper = ErrPropertyNames{}
 	_ ErrorWrapper = ErrAnyOf{}
+	_ ErrorWrapper = ErrMessage{}
 )
 
+// knownErrors is the list of all built-in error types.
+//
+// Used to map error codes back to error types when reading translation files
+// and to find missing translations in [Locale.Missing].
+// [Errors] and [ErrMessage] aren't included because they cannot be [Locale] keys.
+var knownErrors = []Error{
+	ErrNoInput{},
+	ErrProperty{},
+	ErrIndex{},
+	ErrType{},
+	ErrDecimal{},
+	ErrBigInt{},
+	ErrRequired{},
+	ErrUnexpected{},
+	ErrUnexpectedItem{},
+	ErrConst{},
+	ErrEnum{},
+	ErrMultipleOf{},
+	ErrNot{},
+	ErrAnyOf{},
+	ErrMin{},
+	ErrExclMin{},
+	ErrMax{},
+	ErrExclMax{},
+	ErrMinLen{},
+	ErrMaxLen{},
+	ErrMinGraphemes{},
+	ErrMaxGraphemes{},
+	ErrMaxBytes{},
+	ErrPrecision{},
+	ErrScale{},
+	ErrPattern{},
+	ErrContains{},
+	ErrMinContains{},
+	ErrMaxContains{},
+	ErrMinItems{},
+	ErrMaxItems{},
+	ErrUniqueItems{},
+	ErrPropertyNames{},
+	ErrMinProperties{},
+	ErrMaxProperties{},
+	ErrLessField{},
+	ErrLessOrEqualField{},
+	ErrEqualField{},
+	ErrAtLeastOneOf{},
+	ErrExactlyOneOf{},
+	ErrCheck{},
+	ErrNotFound{},
+	ErrExists{},
+	ErrReadOnly{},
+	ErrWriteOnly{},
+	ErrDeprecated{},
+}
+
 type pair struct {
 	name  string
 	value any
 }
 
+// paramsMap converts the error parameters into the format returned by Params method.
+func paramsMap(pairs []pair) map[string]any {
+	res := make(map[string]any, len(pairs))
+	for _, p := range pairs {
+		t, isTerm := p.value.(Term)
+		if isTerm {
+			res[p.name] = string(t)
+		} else {
+			res[p.name] = p.value
+		}
+	}
+	return res
+}
+
 // Format substitutes values into a format string with python-style placeholders.
+//
+// Plural and select blocks (see [resolveMessage]) that aren't resolved yet
+// by [Locale] are resolved using English plural rules.
 func format(f string, pairs ...pair) string {
 	args := make([]string, 0, len(pairs)*2)
 	for _, p := range pairs {
 		args = append(args, "{"+p.name+"}")
 		args = append(args, fmt.Sprintf("%v", p.value))
 	}
-	return strings.NewReplacer(args...).Replace(f)
+	replacer := strings.NewReplacer(args...)
+	get := func(name string) string {
+		return replacer.Replace("{" + name + "}")
+	}
+	f = resolveMessage(f, pluralRuleOneNoFraction, get)
+	return replacer.Replace(f)
 }
 
 // A collection of multiple errors.
@@ -76,6 +191,16 @@ func (es Errors) GetDefault() Error {
 	return Errors{}
 }
 
+// Code implements [Coder] interface.
+func (es Errors) Code() string {
+	return "errors"
+}
+
+// Params implements [Parametrized] interface.
+func (es Errors) Params() map[string]any {
+	return map[string]any{"errors": es.Errs}
+}
+
 // SetFormat implements [Error] interface.
 func (es Errors) SetFormat(f string) Error {
 	es.Sep = f
@@ -130,6 +255,16 @@ func (e ErrNoInput) GetDefault() Error {
 	return ErrNoInput{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrNoInput) Code() string {
+	return "no_input"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrNoInput) Params() map[string]any {
+	return nil
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrNoInput) SetFormat(f string) Error {
 	e.Format = f
@@ -159,6 +294,16 @@ func (e ErrProperty) GetDefault() Error {
 	return ErrProperty{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrProperty) Code() string {
+	return "property"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrProperty) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrProperty) SetFormat(f string) Error {
 	e.Format = f
@@ -171,7 +316,11 @@ func (e ErrProperty) Error() string {
 	if f == "" {
 		f = "{name}: {error}"
 	}
-	return format(f, pair{"name", e.Name}, pair{"error", e.Err})
+	return format(f, e.params()...)
+}
+
+func (e ErrProperty) params() []pair {
+	return []pair{{"name", e.Name}, {"error", e.Err}}
 }
 
 // Unwrap implements [ErrorWrapper] interface.
@@ -199,6 +348,16 @@ func (e ErrIndex) GetDefault() Error {
 	return ErrIndex{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrIndex) Code() string {
+	return "index"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrIndex) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrIndex) SetFormat(f string) Error {
 	e.Format = f
@@ -211,7 +370,11 @@ func (e ErrIndex) Error() string {
 	if f == "" {
 		f = "at {index}: {error}"
 	}
-	return format(f, pair{"index", e.Index}, pair{"error", e.Err})
+	return format(f, e.params()...)
+}
+
+func (e ErrIndex) params() []pair {
+	return []pair{{"index", e.Index}, {"error", e.Err}}
 }
 
 // Unwrap implements [ErrorWrapper] interface.
@@ -237,6 +400,16 @@ func (e ErrType) GetDefault() Error {
 	return ErrType{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrType) Code() string {
+	return "type"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrType) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrType) SetFormat(f string) Error {
 	e.Format = f
@@ -249,11 +422,15 @@ func (e ErrType) Error() string {
 	if f == "" {
 		f = "invalid type: got {got}, expected {expected}"
 	}
+	return format(f, e.params()...)
+}
+
+func (e ErrType) params() []pair {
 	got := e.Got
 	if got == "" {
 		got = "unknown type"
 	}
-	return format(f, pair{"got", got}, pair{"expected", e.Expected})
+	return []pair{{"got", Term(got)}, {"expected", Term(e.Expected)}}
 }
 
 // An error indicating that a value is required but not found.
@@ -269,6 +446,16 @@ func (e ErrRequired) GetDefault() Error {
 	return ErrRequired{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrRequired) Code() string {
+	return "required"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrRequired) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrRequired) SetFormat(f string) Error {
 	e.Format = f
@@ -281,12 +468,16 @@ func (e ErrRequired) Error() string {
 	if f == "" {
 		f = "{name} is required but not found"
 	}
-	return format(f, pair{"name", e.Name})
+	return format(f, e.params()...)
+}
+
+func (e ErrRequired) params() []pair {
+	return []pair{{"name", e.Name}}
 }
 
 // An error indicating that the property is not allowed.
 //
-// Returned by an [Object] validator.
+// Returned by an [Object] validator and [UnevaluatedProperties].
 type ErrUnexpected struct {
 	Format string
 	Name   string
@@ -297,6 +488,16 @@ func (e ErrUnexpected) GetDefault() Error {
 	return ErrUnexpected{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrUnexpected) Code() string {
+	return "unexpected"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrUnexpected) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrUnexpected) SetFormat(f string) Error {
 	e.Format = f
@@ -309,12 +510,53 @@ func (e ErrUnexpected) Error() string {
 	if f == "" {
 		f = "unexpected property: {name}"
 	}
-	return format(f, pair{"name", e.Name})
+	return format(f, e.params()...)
+}
+
+func (e ErrUnexpected) params() []pair {
+	return []pair{{"name", e.Name}}
+}
+
+// An error indicating that the array item is not allowed.
+//
+// Returned by [UnevaluatedItems].
+type ErrUnexpectedItem struct {
+	Format string
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrUnexpectedItem) GetDefault() Error {
+	return ErrUnexpectedItem{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrUnexpectedItem) Code() string {
+	return "unexpected_item"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrUnexpectedItem) Params() map[string]any {
+	return nil
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrUnexpectedItem) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrUnexpectedItem) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "unexpected item"
+	}
+	return f
 }
 
 // An error indicating that the value isn't equal to the expected constant.
 //
-// Returned by [StringConst], [IntConst], and [BoolConst] validators.
+// Returned by [StringConst], [IntConst], [Float64Const], [BoolConst], and [JSONConst] validators.
 type ErrConst struct {
 	Format   string
 	Got      any
@@ -326,6 +568,16 @@ func (e ErrConst) GetDefault() Error {
 	return ErrConst{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrConst) Code() string {
+	return "const"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrConst) Params() map[string]any {
+	return map[string]any{"expected": e.Expected}
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrConst) SetFormat(f string) Error {
 	e.Format = f
@@ -336,18 +588,27 @@ func (e ErrConst) SetFormat(f string) Error {
 func (e ErrConst) Error() string {
 	f := e.Format
 	if f == "" {
-		f = `expected the value to be equal to "{expected}"`
+		f = "expected the value to be equal to {expected}"
 	}
-	return format(f, pair{"expected", e.Expected})
+	return format(f, e.params()...)
+}
+
+func (e ErrConst) params() []pair {
+	return []pair{{"expected", valueParam(e.Expected)}}
 }
 
 // An error indicating that the value isn't equal to any of the allowed values.
 //
-// Returned by the [Enum] validator.
+// Returned by the [Enum], [EnumOf], and [JSONEnum] validators.
 type ErrEnum struct {
-	Format   string
-	Got      string
-	Expected []string
+	Format string
+	// Got is the input value. For numbers and [JSONEnum], it's encoded as JSON.
+	Got string
+	// Expected is the list of allowed values: []string for [Enum] of strings
+	// and [EnumOf], []float64 for [Enum] of numbers, and []any for [JSONEnum].
+	//
+	// It's not a slice to keep the error comparable, so that it can be used in [Locale].
+	Expected any
 }
 
 // GetDefault implements [Error] interface.
@@ -355,6 +616,16 @@ func (e ErrEnum) GetDefault() Error {
 	return ErrEnum{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrEnum) Code() string {
+	return "enum"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrEnum) Params() map[string]any {
+	return map[string]any{"expected": e.Expected}
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrEnum) SetFormat(f string) Error {
 	e.Format = f
@@ -367,7 +638,140 @@ func (e ErrEnum) Error() string {
 	if f == "" {
 		f = `expected the value to be one of: {expected}`
 	}
-	return format(f, pair{"expected", strings.Join(e.Expected, ", ")})
+	return format(f, e.params()...)
+}
+
+func (e ErrEnum) params() []pair {
+	return []pair{{"expected", joinValues(e.Expected)}}
+}
+
+// joinValues prepares a list of values to be used in a message.
+//
+// Strings are joined right away, other values are kept as [valueList]
+// to be formatted for the language of the message.
+func joinValues(vs any) any {
+	switch vs := vs.(type) {
+	case []string:
+		return strings.Join(vs, ", ")
+	case []float64:
+		res := make(valueList, len(vs))
+		for i, v := range vs {
+			res[i] = v
+		}
+		return res
+	case []any:
+		return valueList(vs)
+	default:
+		return vs
+	}
+}
+
+// valueList is a comma-separated list of values in a message.
+//
+// Numbers in it use the decimal separator of the language, see [locVal.localizeParams].
+type valueList []any
+
+// String implements [fmt.Stringer].
+func (l valueList) String() string {
+	return l.format(".")
+}
+
+func (l valueList) format(sep string) string {
+	res := make([]string, len(l))
+	for i, v := range l {
+		switch v := valueParam(v).(type) {
+		case float64:
+			res[i] = formatFloat(v, sep)
+		default:
+			res[i] = fmt.Sprintf("%v", v)
+		}
+	}
+	return strings.Join(res, ", ")
+}
+
+// valueParam prepares the expected value to be used in a message.
+//
+// Strings are quoted to be distinguished from other JSON values,
+// like "null" and null, and raw JSON is used as is.
+func valueParam(v any) any {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	case json.RawMessage:
+		return string(v)
+	default:
+		return v
+	}
+}
+
+// An error returned by [Decimal] for a string that is not a decimal number.
+type ErrDecimal struct {
+	Format string
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrDecimal) GetDefault() Error {
+	return ErrDecimal{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrDecimal) Code() string {
+	return "decimal"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrDecimal) Params() map[string]any {
+	return nil
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrDecimal) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrDecimal) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must be a decimal number"
+	}
+	return f
+}
+
+// An error returned by [BigInt] for a string that is not an integer number.
+type ErrBigInt struct {
+	Format string
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrBigInt) GetDefault() Error {
+	return ErrBigInt{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrBigInt) Code() string {
+	return "big_int"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrBigInt) Params() map[string]any {
+	return nil
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrBigInt) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrBigInt) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must be an integer number"
+	}
+	return f
 }
 
 // A constraint error returned by [MultipleOf].
@@ -381,6 +785,16 @@ func (e ErrMultipleOf) GetDefault() Error {
 	return ErrMultipleOf{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrMultipleOf) Code() string {
+	return "multiple_of"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMultipleOf) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrMultipleOf) SetFormat(f string) Error {
 	e.Format = f
@@ -393,7 +807,11 @@ func (e ErrMultipleOf) Error() string {
 	if f == "" {
 		f = "must be a multiple of {value}"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
+}
+
+func (e ErrMultipleOf) params() []pair {
+	return []pair{{"value", e.Value}}
 }
 
 // An error returned by [Not] validator.
@@ -406,6 +824,16 @@ func (e ErrNot) GetDefault() Error {
 	return ErrNot{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrNot) Code() string {
+	return "not"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrNot) Params() map[string]any {
+	return nil
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrNot) SetFormat(f string) Error {
 	e.Format = f
@@ -448,6 +876,16 @@ func (e ErrAnyOf) GetDefault() Error {
 	return ErrAnyOf{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrAnyOf) Code() string {
+	return "any_of"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrAnyOf) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrAnyOf) SetFormat(f string) Error {
 	e.Format = f
@@ -460,7 +898,11 @@ func (e ErrAnyOf) Error() string {
 	if f == "" {
 		f = "must match any of the conditions: {errors}"
 	}
-	return format(f, pair{"errors", e.Errors})
+	return format(f, e.params()...)
+}
+
+func (e ErrAnyOf) params() []pair {
+	return []pair{{"errors", e.Errors}}
 }
 
 // A constraint error returned by [Min].
@@ -474,6 +916,16 @@ func (e ErrMin) GetDefault() Error {
 	return ErrMin{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrMin) Code() string {
+	return "min"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMin) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrMin) SetFormat(f string) Error {
 	e.Format = f
@@ -486,7 +938,11 @@ func (e ErrMin) Error() string {
 	if f == "" {
 		f = "must be greater than or equal to {value}"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
+}
+
+func (e ErrMin) params() []pair {
+	return []pair{{"value", e.Value}}
 }
 
 // A constraint error returned by [ExclMin].
@@ -500,6 +956,16 @@ func (e ErrExclMin) GetDefault() Error {
 	return ErrExclMin{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrExclMin) Code() string {
+	return "excl_min"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrExclMin) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrExclMin) SetFormat(f string) Error {
 	e.Format = f
@@ -512,7 +978,11 @@ func (e ErrExclMin) Error() string {
 	if f == "" {
 		f = "must be greater than {value}"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
+}
+
+func (e ErrExclMin) params() []pair {
+	return []pair{{"value", e.Value}}
 }
 
 // A constraint error returned by [Max].
@@ -526,6 +996,16 @@ func (e ErrMax) GetDefault() Error {
 	return ErrMax{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrMax) Code() string {
+	return "max"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMax) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrMax) SetFormat(f string) Error {
 	e.Format = f
@@ -538,7 +1018,11 @@ func (e ErrMax) Error() string {
 	if f == "" {
 		f = "must be less than or equal to {value}"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
+}
+
+func (e ErrMax) params() []pair {
+	return []pair{{"value", e.Value}}
 }
 
 // A constraint error returned by [ExclMax].
@@ -552,6 +1036,16 @@ func (e ErrExclMax) GetDefault() Error {
 	return ErrExclMax{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrExclMax) Code() string {
+	return "excl_max"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrExclMax) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrExclMax) SetFormat(f string) Error {
 	e.Format = f
@@ -564,7 +1058,11 @@ func (e ErrExclMax) Error() string {
 	if f == "" {
 		f = "must be less than {value}"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
+}
+
+func (e ErrExclMax) params() []pair {
+	return []pair{{"value", e.Value}}
 }
 
 // A constraint error returned by [MinLen].
@@ -578,6 +1076,16 @@ func (e ErrMinLen) GetDefault() Error {
 	return ErrMinLen{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrMinLen) Code() string {
+	return "min_len"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMinLen) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrMinLen) SetFormat(f string) Error {
 	e.Format = f
@@ -588,9 +1096,13 @@ func (e ErrMinLen) SetFormat(f string) Error {
 func (e ErrMinLen) Error() string {
 	f := e.Format
 	if f == "" {
-		f = "must be at least {value} characters long"
+		f = "must be at least {value, plural, one {# character} other {# characters}} long"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
+}
+
+func (e ErrMinLen) params() []pair {
+	return []pair{{"value", e.Value}}
 }
 
 // A constraint error returned by [MaxLen].
@@ -604,6 +1116,16 @@ func (e ErrMaxLen) GetDefault() Error {
 	return ErrMaxLen{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrMaxLen) Code() string {
+	return "max_len"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMaxLen) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrMaxLen) SetFormat(f string) Error {
 	e.Format = f
@@ -614,97 +1136,339 @@ func (e ErrMaxLen) SetFormat(f string) Error {
 func (e ErrMaxLen) Error() string {
 	f := e.Format
 	if f == "" {
-		f = "must be at most {value} characters long"
+		f = "must be at most {value, plural, one {# character} other {# characters}} long"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
 }
 
-// A constraint error returned by [Pattern].
-type ErrPattern struct {
+func (e ErrMaxLen) params() []pair {
+	return []pair{{"value", e.Value}}
+}
+
+// A constraint error returned by [MinGraphemes].
+type ErrMinGraphemes struct {
 	Format string
+	Value  int
 }
 
 // GetDefault implements [Error] interface.
-func (e ErrPattern) GetDefault() Error {
-	return ErrPattern{}
+func (e ErrMinGraphemes) GetDefault() Error {
+	return ErrMinGraphemes{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrMinGraphemes) Code() string {
+	return "min_graphemes"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMinGraphemes) Params() map[string]any {
+	return paramsMap(e.params())
 }
 
 // SetFormat implements [Error] interface.
-func (e ErrPattern) SetFormat(f string) Error {
+func (e ErrMinGraphemes) SetFormat(f string) Error {
 	e.Format = f
 	return e
 }
 
 // Error implements [error] interface.
-func (e ErrPattern) Error() string {
+func (e ErrMinGraphemes) Error() string {
 	f := e.Format
 	if f == "" {
-		f = "must match the pattern"
+		f = "must be at least {value, plural, one {# character} other {# characters}} long"
 	}
-	return f
+	return format(f, e.params()...)
 }
 
-// A constraint error returned by [Contains].
-type ErrContains struct {
+func (e ErrMinGraphemes) params() []pair {
+	return []pair{{"value", e.Value}}
+}
+
+// A constraint error returned by [MaxGraphemes].
+type ErrMaxGraphemes struct {
 	Format string
-	Err    Error
+	Value  int
 }
 
 // GetDefault implements [Error] interface.
-func (e ErrContains) GetDefault() Error {
-	return ErrContains{}
+func (e ErrMaxGraphemes) GetDefault() Error {
+	return ErrMaxGraphemes{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrMaxGraphemes) Code() string {
+	return "max_graphemes"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMaxGraphemes) Params() map[string]any {
+	return paramsMap(e.params())
 }
 
 // SetFormat implements [Error] interface.
-func (e ErrContains) SetFormat(f string) Error {
+func (e ErrMaxGraphemes) SetFormat(f string) Error {
 	e.Format = f
 	return e
 }
 
 // Error implements [error] interface.
-func (e ErrContains) Error() string {
+func (e ErrMaxGraphemes) Error() string {
 	f := e.Format
 	if f == "" {
-		f = "at least one item {error}"
+		f = "must be at most {value, plural, one {# character} other {# characters}} long"
 	}
-	return format(f, pair{"error", e.Err})
-}
-
-// Unwrap implements [ErrorWrapper] interface.
-func (e ErrContains) Unwrap() error {
-	return e.Err
+	return format(f, e.params()...)
 }
 
-// Map implements [ErrorWrapper] interface.
-func (e ErrContains) Map(f func(Error) Error) Error {
-	e.Err = f(e.Err)
-	return e
+func (e ErrMaxGraphemes) params() []pair {
+	return []pair{{"value", e.Value}}
 }
 
-// A constraint error returned by [MinItems].
-type ErrMinItems struct {
+// A constraint error returned by [MaxBytes].
+type ErrMaxBytes struct {
 	Format string
 	Value  int
 }
 
 // GetDefault implements [Error] interface.
-func (e ErrMinItems) GetDefault() Error {
-	return ErrMinItems{}
+func (e ErrMaxBytes) GetDefault() Error {
+	return ErrMaxBytes{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrMaxBytes) Code() string {
+	return "max_bytes"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMaxBytes) Params() map[string]any {
+	return paramsMap(e.params())
 }
 
 // SetFormat implements [Error] interface.
-func (e ErrMinItems) SetFormat(f string) Error {
+func (e ErrMaxBytes) SetFormat(f string) Error {
 	e.Format = f
 	return e
 }
 
 // Error implements [error] interface.
-func (e ErrMinItems) Error() string {
+func (e ErrMaxBytes) Error() string {
 	f := e.Format
 	if f == "" {
-		f = "must contain at least {value} items"
+		f = "must be at most {value, plural, one {# byte} other {# bytes}} long"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
+}
+
+func (e ErrMaxBytes) params() []pair {
+	return []pair{{"value", e.Value}}
+}
+
+// A constraint error returned by [Precision].
+type ErrPrecision struct {
+	Format string
+	Value  int
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrPrecision) GetDefault() Error {
+	return ErrPrecision{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrPrecision) Code() string {
+	return "precision"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrPrecision) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrPrecision) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrPrecision) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must have at most {value, plural, one {# digit} other {# digits}}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrPrecision) params() []pair {
+	return []pair{{"value", e.Value}}
+}
+
+// A constraint error returned by [Scale].
+type ErrScale struct {
+	Format string
+	Value  int
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrScale) GetDefault() Error {
+	return ErrScale{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrScale) Code() string {
+	return "scale"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrScale) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrScale) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrScale) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must have at most {value, plural, one {# digit} other {# digits}} after the decimal point"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrScale) params() []pair {
+	return []pair{{"value", e.Value}}
+}
+
+// A constraint error returned by [Pattern].
+type ErrPattern struct {
+	Format string
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrPattern) GetDefault() Error {
+	return ErrPattern{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrPattern) Code() string {
+	return "pattern"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrPattern) Params() map[string]any {
+	return nil
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrPattern) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrPattern) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must match the pattern"
+	}
+	return f
+}
+
+// A constraint error returned by [Contains].
+type ErrContains struct {
+	Format string
+	Err    Error
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrContains) GetDefault() Error {
+	return ErrContains{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrContains) Code() string {
+	return "contains"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrContains) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrContains) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrContains) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "no item matches: {error}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrContains) params() []pair {
+	return []pair{{"error", e.Err}}
+}
+
+// Unwrap implements [ErrorWrapper] interface.
+func (e ErrContains) Unwrap() error {
+	return e.Err
+}
+
+// Map implements [ErrorWrapper] interface.
+func (e ErrContains) Map(f func(Error) Error) Error {
+	e.Err = f(e.Err)
+	return e
+}
+
+// A constraint error returned by [MinItems].
+type ErrMinItems struct {
+	Format string
+	Value  int
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrMinItems) GetDefault() Error {
+	return ErrMinItems{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrMinItems) Code() string {
+	return "min_items"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMinItems) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrMinItems) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrMinItems) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must contain at least {value, plural, one {# item} other {# items}}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrMinItems) params() []pair {
+	return []pair{{"value", e.Value}}
 }
 
 // A constraint error returned by [MaxItems].
@@ -718,6 +1482,16 @@ func (e ErrMaxItems) GetDefault() Error {
 	return ErrMaxItems{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrMaxItems) Code() string {
+	return "max_items"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMaxItems) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrMaxItems) SetFormat(f string) Error {
 	e.Format = f
@@ -728,9 +1502,13 @@ func (e ErrMaxItems) SetFormat(f string) Error {
 func (e ErrMaxItems) Error() string {
 	f := e.Format
 	if f == "" {
-		f = "must contain at most {value} items"
+		f = "must contain at most {value, plural, one {# item} other {# items}}"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
+}
+
+func (e ErrMaxItems) params() []pair {
+	return []pair{{"value", e.Value}}
 }
 
 // A constraint error returned by [PropertyNames].
@@ -745,6 +1523,16 @@ func (e ErrPropertyNames) GetDefault() Error {
 	return ErrPropertyNames{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrPropertyNames) Code() string {
+	return "property_names"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrPropertyNames) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrPropertyNames) SetFormat(f string) Error {
 	e.Format = f
@@ -757,7 +1545,11 @@ func (e ErrPropertyNames) Error() string {
 	if f == "" {
 		f = "property name {name} {error}"
 	}
-	return format(f, pair{"name", e.Name}, pair{"error", e.Err})
+	return format(f, e.params()...)
+}
+
+func (e ErrPropertyNames) params() []pair {
+	return []pair{{"name", e.Name}, {"error", e.Err}}
 }
 
 // Unwrap implements [ErrorWrapper] interface.
@@ -782,6 +1574,16 @@ func (e ErrMinProperties) GetDefault() Error {
 	return ErrMinProperties{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrMinProperties) Code() string {
+	return "min_properties"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMinProperties) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrMinProperties) SetFormat(f string) Error {
 	e.Format = f
@@ -792,9 +1594,13 @@ func (e ErrMinProperties) SetFormat(f string) Error {
 func (e ErrMinProperties) Error() string {
 	f := e.Format
 	if f == "" {
-		f = "must contain at least {value} properties"
+		f = "must contain at least {value, plural, one {# property} other {# properties}}"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
+}
+
+func (e ErrMinProperties) params() []pair {
+	return []pair{{"value", e.Value}}
 }
 
 // A constraint error returned by [MaxProperties].
@@ -808,6 +1614,16 @@ func (e ErrMaxProperties) GetDefault() Error {
 	return ErrMaxProperties{}
 }
 
+// Code implements [Coder] interface.
+func (e ErrMaxProperties) Code() string {
+	return "max_properties"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMaxProperties) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
 // SetFormat implements [Error] interface.
 func (e ErrMaxProperties) SetFormat(f string) Error {
 	e.Format = f
@@ -818,7 +1634,736 @@ func (e ErrMaxProperties) SetFormat(f string) Error {
 func (e ErrMaxProperties) Error() string {
 	f := e.Format
 	if f == "" {
-		f = "must contain at most {value} properties"
+		f = "must contain at most {value, plural, one {# property} other {# properties}}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrMaxProperties) params() []pair {
+	return []pair{{"value", e.Value}}
+}
+
+// An error indicating that a context-aware check couldn't be performed.
+//
+// Returned by [CheckContext], [FuncContext], [Exists], and [NotExists]
+// if the check fails or the context is canceled.
+//
+// The cause is not included in the message or the parameters
+// to not expose internal details (like database errors) to clients.
+// Use errors.Is or errors.As to inspect it.
+type ErrCheck struct {
+	Format string
+	Err    error
+}
+
+// Unwrap makes it possible for errors.Is to check the reason of the failure.
+func (e ErrCheck) Unwrap() error {
+	return e.Err
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrCheck) GetDefault() Error {
+	return ErrCheck{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrCheck) Code() string {
+	return "check"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrCheck) Params() map[string]any {
+	return nil
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrCheck) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrCheck) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "cannot check the value"
+	}
+	return f
+}
+
+// A constraint error returned by [Exists].
+type ErrNotFound struct {
+	Format string
+	Value  any
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrNotFound) GetDefault() Error {
+	return ErrNotFound{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrNotFound) Code() string {
+	return "not_found"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrNotFound) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrNotFound) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrNotFound) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "{value} is not found"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrNotFound) params() []pair {
+	return []pair{{"value", e.Value}}
+}
+
+// A constraint error returned by [NotExists].
+type ErrExists struct {
+	Format string
+	Value  any
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrExists) GetDefault() Error {
+	return ErrExists{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrExists) Code() string {
+	return "exists"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrExists) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrExists) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrExists) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "{value} already exists"
 	}
-	return format(f, pair{"value", e.Value})
+	return format(f, e.params()...)
+}
+
+func (e ErrExists) params() []pair {
+	return []pair{{"value", e.Value}}
+}
+
+// A constraint error returned by [Less].
+type ErrLessField struct {
+	Format string
+	// Name is the property that the value is compared with.
+	Name string
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrLessField) GetDefault() Error {
+	return ErrLessField{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrLessField) Code() string {
+	return "less_field"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrLessField) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrLessField) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrLessField) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must be less than {name}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrLessField) params() []pair {
+	return []pair{{"name", e.Name}}
+}
+
+// A constraint error returned by [LessOrEqual].
+type ErrLessOrEqualField struct {
+	Format string
+	// Name is the property that the value is compared with.
+	Name string
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrLessOrEqualField) GetDefault() Error {
+	return ErrLessOrEqualField{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrLessOrEqualField) Code() string {
+	return "less_or_equal_field"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrLessOrEqualField) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrLessOrEqualField) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrLessOrEqualField) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must be less than or equal to {name}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrLessOrEqualField) params() []pair {
+	return []pair{{"name", e.Name}}
+}
+
+// A constraint error returned by [EqualFields].
+type ErrEqualField struct {
+	Format string
+	// Name is the property that the value is compared with.
+	Name string
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrEqualField) GetDefault() Error {
+	return ErrEqualField{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrEqualField) Code() string {
+	return "equal_field"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrEqualField) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrEqualField) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrEqualField) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must be equal to {name}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrEqualField) params() []pair {
+	return []pair{{"name", e.Name}}
+}
+
+// A constraint error returned by [AtLeastOneOf].
+type ErrAtLeastOneOf struct {
+	Format string
+	// Names is the list of properties, []string.
+	//
+	// It's not a slice to keep the error comparable, so that it can be used in [Locale].
+	Names any
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrAtLeastOneOf) GetDefault() Error {
+	return ErrAtLeastOneOf{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrAtLeastOneOf) Code() string {
+	return "at_least_one_of"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrAtLeastOneOf) Params() map[string]any {
+	return map[string]any{"names": e.Names}
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrAtLeastOneOf) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrAtLeastOneOf) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "at least one of the properties is required: {names}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrAtLeastOneOf) params() []pair {
+	return []pair{{"names", joinValues(e.Names)}}
+}
+
+// A constraint error returned by [ExactlyOneOf].
+type ErrExactlyOneOf struct {
+	Format string
+	// Names is the list of properties, []string.
+	//
+	// It's not a slice to keep the error comparable, so that it can be used in [Locale].
+	Names any
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrExactlyOneOf) GetDefault() Error {
+	return ErrExactlyOneOf{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrExactlyOneOf) Code() string {
+	return "exactly_one_of"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrExactlyOneOf) Params() map[string]any {
+	return map[string]any{"names": e.Names}
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrExactlyOneOf) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrExactlyOneOf) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "exactly one of the properties is required: {names}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrExactlyOneOf) params() []pair {
+	return []pair{{"names", joinValues(e.Names)}}
+}
+
+// A constraint error returned by [UniqueItems] and [UniqueBy].
+//
+// It's wrapped into [ErrIndex] with the index of the duplicate.
+type ErrUniqueItems struct {
+	Format string
+	// Index is the index of the first item with the same value.
+	Index int
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrUniqueItems) GetDefault() Error {
+	return ErrUniqueItems{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrUniqueItems) Code() string {
+	return "unique_items"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrUniqueItems) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrUniqueItems) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrUniqueItems) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must be unique, the same as the item at {index}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrUniqueItems) params() []pair {
+	return []pair{{"index", e.Index}}
+}
+
+// A constraint error returned by [MinContains].
+type ErrMinContains struct {
+	Format string
+	// Value is the required number of matching items.
+	Value int
+	// Count is the actual number of matching items.
+	Count int
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrMinContains) GetDefault() Error {
+	return ErrMinContains{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrMinContains) Code() string {
+	return "min_contains"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMinContains) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrMinContains) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrMinContains) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must contain at least {value, plural, one {# matching item} other {# matching items}}, found {count}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrMinContains) params() []pair {
+	return []pair{{"value", e.Value}, {"count", e.Count}}
+}
+
+// A constraint error returned by [MaxContains].
+type ErrMaxContains struct {
+	Format string
+	// Value is the required number of matching items.
+	Value int
+	// Count is the actual number of matching items.
+	Count int
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrMaxContains) GetDefault() Error {
+	return ErrMaxContains{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrMaxContains) Code() string {
+	return "max_contains"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMaxContains) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrMaxContains) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrMaxContains) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must contain at most {value, plural, one {# matching item} other {# matching items}}, found {count}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrMaxContains) params() []pair {
+	return []pair{{"value", e.Value}, {"count", e.Count}}
+}
+
+// An error indicating that a read-only value is present in a request.
+//
+// Returned by [Meta] with ReadOnly when validating in [ModeRequest].
+type ErrReadOnly struct {
+	Format string
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrReadOnly) GetDefault() Error {
+	return ErrReadOnly{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrReadOnly) Code() string {
+	return "read_only"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrReadOnly) Params() map[string]any {
+	return nil
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrReadOnly) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrReadOnly) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must not be present in requests"
+	}
+	return f
+}
+
+// An error indicating that a write-only value is present in a response.
+//
+// Returned by [Meta] with WriteOnly when validating in [ModeResponse].
+type ErrWriteOnly struct {
+	Format string
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrWriteOnly) GetDefault() Error {
+	return ErrWriteOnly{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrWriteOnly) Code() string {
+	return "write_only"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrWriteOnly) Params() map[string]any {
+	return nil
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrWriteOnly) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrWriteOnly) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "must not be present in responses"
+	}
+	return f
+}
+
+// A warning indicating that the value is deprecated.
+//
+// Reported by [Meta] and [EnumOf] values with Deprecated, see [WithWarnings].
+type ErrDeprecated struct {
+	Format string
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrDeprecated) GetDefault() Error {
+	return ErrDeprecated{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrDeprecated) Code() string {
+	return "deprecated"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrDeprecated) Params() map[string]any {
+	return nil
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrDeprecated) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrDeprecated) Error() string {
+	f := e.Format
+	if f == "" {
+		f = "is deprecated"
+	}
+	return f
+}
+
+// An error with a custom message set by [WithMessage] or [Constraint.Message].
+//
+// Since it contains a map, it cannot be used as a [Locale] key. Instead,
+// when translated, the message for the locale language is selected from Messages.
+type ErrMessage struct {
+	Format   string
+	Messages Messages
+	// Lang is the language of the message to use, set when translating the error.
+	Lang string
+	// Err is the original error replaced by the custom message.
+	Err Error
+}
+
+// Map implements [ErrorWrapper] interface.
+func (e ErrMessage) Map(f func(Error) Error) Error {
+	e.Err = f(e.Err)
+	return e
+}
+
+// Unwrap implements [ErrorWrapper] interface.
+func (e ErrMessage) Unwrap() error {
+	return e.Err
+}
+
+// GetDefault implements [Error] interface.
+func (e ErrMessage) GetDefault() Error {
+	return ErrMessage{}
+}
+
+// Code implements [Coder] interface.
+func (e ErrMessage) Code() string {
+	return "message"
+}
+
+// Params implements [Parametrized] interface.
+func (e ErrMessage) Params() map[string]any {
+	return paramsMap(e.params())
+}
+
+// SetFormat implements [Error] interface.
+func (e ErrMessage) SetFormat(f string) Error {
+	e.Format = f
+	return e
+}
+
+// Error implements [error] interface.
+func (e ErrMessage) Error() string {
+	f := e.Format
+	if f == "" {
+		f = e.Messages.get(e.Lang)
+	}
+	if f == "" {
+		f = "{error}"
+	}
+	return format(f, e.params()...)
+}
+
+func (e ErrMessage) params() []pair {
+	return []pair{{"error", e.Err}}
+}
+
+// ErrorJSON converts the error tree into a JSON object with the error code,
+// message, and parameters, with nested errors converted recursively.
+//
+//	{"code": "min_len", "message": "must be at least 3 characters long", "params": {"value": 3}}
+//
+// The code and parameters are included if the error implements [Coder]
+// and [Parametrized], like all built-in errors do.
+// If the error is not an [Error] (for example, the input is not a valid JSON),
+// only the message is included.
+//
+//	body := jsony.EncodeBytes(valdo.ErrorJSON(err))
+func ErrorJSON(err error) jsony.Object {
+	e, ok := err.(Error)
+	if !ok {
+		return jsony.Object{
+			jsony.Field{K: "message", V: jsony.String(err.Error())},
+		}
+	}
+	res := jsony.Object{}
+	code := errorCode(e)
+	if code != "" {
+		res = append(res, jsony.Field{K: "code", V: jsony.String(code)})
+	}
+	res = append(res, jsony.Field{K: "message", V: jsony.String(e.Error())})
+	p, ok := e.(Parametrized)
+	if !ok {
+		return res
+	}
+	params := p.Params()
+	if len(params) == 0 {
+		return res
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	obj := make(jsony.UnsafeObject, len(names))
+	for i, name := range names {
+		obj[i] = jsony.UnsafeField{K: jsony.String(name), V: paramJSON(params[name])}
+	}
+	return append(res, jsony.Field{K: "params", V: obj})
+}
+
+// paramJSON converts a value returned by Params method into JSON.
+func paramJSON(v any) jsony.Encoder {
+	switch v := v.(type) {
+	case Error:
+		return ErrorJSON(v)
+	case []Error:
+		res := make(jsony.Array[jsony.Object], len(v))
+		for i, e := range v {
+			res[i] = ErrorJSON(e)
+		}
+		return res
+	case []string:
+		res := make(jsony.Array[jsony.String], len(v))
+		for i, s := range v {
+			res[i] = jsony.String(s)
+		}
+		return res
+	case []float64:
+		res := make(jsony.Array[jsony.Float64], len(v))
+		for i, f := range v {
+			res[i] = jsony.Float64(f)
+		}
+		return res
+	case []any:
+		res := make(jsony.MixedArray, len(v))
+		for i, item := range v {
+			res[i] = paramJSON(item)
+		}
+		return res
+	case json.Number:
+		if json.Valid([]byte(v)) {
+			return RawJSON(v)
+		}
+	case json.RawMessage:
+		return RawJSON(v)
+	}
+	res := jsony.UnsafeDetect(v)
+	if res == nil {
+		res = jsony.String(fmt.Sprintf("%v", v))
+	}
+	return res
 }
@@ -0,0 +1,66 @@
+package valdo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/orsinium-labs/jsony"
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestError_Params(t *testing.T) {
+	t.Parallel()
+	err := valdo.ErrMinLen{Value: 3}
+	isEq(err.Code(), "min_len")
+	isEq(err.Params()["value"], any(3))
+
+	typeErr := valdo.ErrType{Expected: "integer"}
+	isEq(typeErr.Params()["got"], any("unknown type"))
+	isEq(typeErr.Params()["expected"], any("integer"))
+
+	isEq(len(valdo.ErrPattern{}.Params()), 0)
+}
+
+func TestErrorJSON(t *testing.T) {
+	t.Parallel()
+	val := valdo.Object(
+		valdo.P("name", valdo.String(valdo.MinLen(3))),
+		valdo.P("age", valdo.Int()),
+	)
+	err := valdo.Validate(val, []byte(`{"name": "x"}`))
+	isEq(
+		string(jsony.EncodeBytes(valdo.ErrorJSON(err))),
+		`{"code":"errors","message":"name: must be at least 3 characters long; age is required but not found","params":{"errors":[`+
+			`{"code":"property","message":"name: must be at least 3 characters long","params":{"error":`+
+			`{"code":"min_len","message":"must be at least 3 characters long","params":{"value":3}},"name":"name"}},`+
+			`{"code":"required","message":"age is required but not found","params":{"name":"age"}}]}}`,
+	)
+
+	err = valdo.Validate(valdo.Enum("a", "b"), []byte(`"c"`))
+	isEq(
+		string(jsony.EncodeBytes(valdo.ErrorJSON(err))),
+		`{"code":"enum","message":"expected the value to be one of: a, b","params":{"expected":["a","b"]}}`,
+	)
+
+	err = valdo.Validate(valdo.DefaultLocales.Wrap("nl", valdo.Int()), []byte(`"hi"`))
+	isEq(
+		string(jsony.EncodeBytes(valdo.ErrorJSON(err))),
+		`{"code":"type","message":"ongeldig type: kreeg tekenreeks, verwachtte geheel getal","params":{"expected":"integer","got":"string"}}`,
+	)
+
+	isEq(string(jsony.EncodeBytes(valdo.ErrorJSON(errors.New("oh no")))), `{"message":"oh no"}`)
+
+	// Custom errors don't have to implement Code and Params.
+	var custom valdo.Error = ErrNoCode{}
+	err = valdo.Validate(valdo.Object(valdo.P("x", valdo.Func(func(any) bool { return false }, custom, nil))), []byte(`{"x": 1}`))
+	isEq(
+		string(jsony.EncodeBytes(valdo.ErrorJSON(err))),
+		`{"code":"property","message":"x: no code","params":{"error":{"message":"no code"},"name":"x"}}`,
+	)
+}
+
+type ErrNoCode struct{}
+
+func (e ErrNoCode) Error() string                  { return "no code" }
+func (e ErrNoCode) GetDefault() valdo.Error        { return e }
+func (e ErrNoCode) SetFormat(f string) valdo.Error { return e }
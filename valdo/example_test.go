@@ -13,7 +13,7 @@ func ExampleDefaultLocales() {
 	input := []byte(`"hi"`)
 	err := valdo.Validate(translated, input)
 	fmt.Println(err)
-	// Output: ongeldig type: kreeg string, verwachtte integer
+	// Output: ongeldig type: kreeg tekenreeks, verwachtte geheel getal
 }
 
 func ExampleLocales() {
@@ -0,0 +1,80 @@
+package valdo
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/orsinium-labs/jsony"
+)
+
+// ExampleError is an example or a default value that doesn't pass its own validator.
+//
+// Returned by [CheckExamples].
+type ExampleError struct {
+	// Location is a JSON pointer to the invalid value in the generated JSON Schema.
+	Location string
+	// Err is the validation error of the value.
+	Err error
+}
+
+// Error implements [error] interface.
+func (e ExampleError) Error() string {
+	return "#" + e.Location + ": " + e.Err.Error()
+}
+
+// Unwrap makes it possible for errors.Unwrap function to access the wrapped error.
+func (e ExampleError) Unwrap() error {
+	return e.Err
+}
+
+// CheckExamples validates all examples and defaults in [Meta] of the validator
+// and all validators nested into it.
+//
+// Each value is validated using the validator the [Meta] wraps.
+// Use it in tests to make sure the documentation doesn't show invalid examples:
+//
+//	for _, err := range valdo.CheckExamples(validator) {
+//		t.Error(err)
+//	}
+func CheckExamples(v Validator) []ExampleError {
+	var res []ExampleError
+	add := func(loc string, m Meta, enc jsony.Encoder) {
+		err := checkEncoded(m.Validator, enc)
+		if err != nil {
+			res = append(res, ExampleError{Location: loc, Err: err})
+		}
+	}
+	walk(v, "", func(loc string, v Validator) {
+		m, ok := v.(Meta)
+		if !ok {
+			return
+		}
+		if m.Default != nil {
+			add(loc+"/default", m, m.Default)
+		}
+		idx := 0
+		if m.Example != nil {
+			add(loc+"/examples/0", m, m.Example)
+			idx++
+		}
+		for _, example := range m.Examples {
+			add(loc+"/examples/"+strconv.Itoa(idx), m, example)
+			idx++
+		}
+	})
+	return res
+}
+
+// checkEncoded encodes the value into JSON and validates it.
+func checkEncoded(v Validator, enc jsony.Encoder) error {
+	var data any
+	err := json.Unmarshal(jsony.EncodeBytes(enc), &data)
+	if err != nil {
+		return err
+	}
+	vErr := v.Validate(data)
+	if vErr != nil {
+		return vErr
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package valdo_test
+
+import (
+	"testing"
+
+	"github.com/orsinium-labs/jsony"
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestCheckExamples(t *testing.T) {
+	t.Parallel()
+	val := valdo.Object(
+		valdo.P("name", valdo.Meta{
+			Validator: valdo.String(valdo.MinLen(2)),
+			Example:   jsony.String("aragorn"),
+			Examples:  []jsony.Encoder{jsony.String("a"), jsony.String("legolas")},
+		}),
+		valdo.P("tags", valdo.Array(valdo.Meta{
+			Validator: valdo.Enum("admin", "user"),
+			Default:   jsony.String("guest"),
+		})),
+		valdo.P("a/b", valdo.Meta{Validator: valdo.Int(), Examples: []jsony.Encoder{jsony.String("1")}}),
+	)
+	errs := valdo.CheckExamples(val)
+	isEq(len(errs), 3)
+	isEq(errs[0].Location, "/properties/name/examples/1")
+	isEq(errs[0].Error(), "#/properties/name/examples/1: must be at least 2 characters long")
+	isErr[valdo.ErrMinLen](errs[0].Err)
+	isEq(errs[1].Location, "/properties/tags/items/default")
+	isErr[valdo.ErrEnum](errs[1].Err)
+	isEq(errs[2].Location, "/properties/a~1b/examples/0")
+	isErr[valdo.ErrType](errs[2].Err)
+
+	valid := valdo.Nullable(valdo.Meta{Validator: valdo.Int(), Default: jsony.Int(1), Example: jsony.Int(2)})
+	isEq(len(valdo.CheckExamples(valid)), 0)
+	isEq(len(valdo.CheckExamples(valdo.Int())), 0)
+}
@@ -0,0 +1,183 @@
+package valdo
+
+import (
+	"reflect"
+
+	"github.com/orsinium-labs/jsony"
+)
+
+// Less requires the value of the property a to be less than the value of the property b.
+//
+// For example, the start of a date range must be before its end.
+// Strings are compared lexicographically, which works for ISO 8601 dates.
+// If any of the properties is missing or has a different type, the constraint passes.
+//
+// There is no JSON Schema keyword for it, so "x-less" extension keyword is used.
+func Less[T int | float64 | string](a, b string) Constraint[map[string]any] {
+	c := func(data map[string]any) Error {
+		va, okA := getField[T](data, a)
+		vb, okB := getField[T](data, b)
+		if !okA || !okB || va < vb {
+			return nil
+		}
+		return ErrProperty{Name: a, Err: ErrLessField{Name: b}}
+	}
+	return Constraint[map[string]any]{
+		check:  c,
+		fields: jsony.Object{{K: "x-less", V: namesArray(a, b)}},
+		props:  []string{a, b},
+	}
+}
+
+// LessOrEqual requires the value of the property a to be less than or equal to the value of the property b.
+//
+// For example, the minimum price must not be greater than the maximum price.
+// If any of the properties is missing or has a different type, the constraint passes.
+//
+// There is no JSON Schema keyword for it, so "x-lessOrEqual" extension keyword is used.
+func LessOrEqual[T int | float64 | string](a, b string) Constraint[map[string]any] {
+	c := func(data map[string]any) Error {
+		va, okA := getField[T](data, a)
+		vb, okB := getField[T](data, b)
+		if !okA || !okB || va <= vb {
+			return nil
+		}
+		return ErrProperty{Name: a, Err: ErrLessOrEqualField{Name: b}}
+	}
+	return Constraint[map[string]any]{
+		check:  c,
+		fields: jsony.Object{{K: "x-lessOrEqual", V: namesArray(a, b)}},
+		props:  []string{a, b},
+	}
+}
+
+// EqualFields requires the property b to have the same value as the property a.
+//
+// For example, the password confirmation must match the password.
+// If any of the properties is missing, the constraint passes.
+//
+// There is no JSON Schema keyword for it, so "x-equalFields" extension keyword is used.
+func EqualFields(a, b string) Constraint[map[string]any] {
+	c := func(data map[string]any) Error {
+		va, okA := data[a]
+		vb, okB := data[b]
+		if !okA || !okB || reflect.DeepEqual(va, vb) {
+			return nil
+		}
+		return ErrProperty{Name: b, Err: ErrEqualField{Name: a}}
+	}
+	return Constraint[map[string]any]{
+		check:  c,
+		fields: jsony.Object{{K: "x-equalFields", V: namesArray(a, b)}},
+		props:  []string{a, b},
+	}
+}
+
+// AtLeastOneOf requires at least one of the given properties to be present.
+//
+// Maps to "anyOf" with "required" for each property.
+func AtLeastOneOf(names ...string) Constraint[map[string]any] {
+	c := func(data map[string]any) Error {
+		if countFields(data, names) > 0 {
+			return nil
+		}
+		return ErrAtLeastOneOf{Names: names}
+	}
+	return Constraint[map[string]any]{
+		check:  c,
+		fields: jsony.Object{{K: "anyOf", V: requiredEach(names)}},
+		props:  names,
+	}
+}
+
+// ExactlyOneOf requires exactly one of the given properties to be present.
+//
+// Maps to "oneOf" with "required" for each property.
+func ExactlyOneOf(names ...string) Constraint[map[string]any] {
+	c := func(data map[string]any) Error {
+		if countFields(data, names) == 1 {
+			return nil
+		}
+		return ErrExactlyOneOf{Names: names}
+	}
+	return Constraint[map[string]any]{
+		check:  c,
+		fields: jsony.Object{{K: "oneOf", V: requiredEach(names)}},
+		props:  names,
+	}
+}
+
+// NoneOf requires none of the given properties to be present.
+//
+// Each present property is reported as [ErrUnexpected].
+// Maps to "not" with "anyOf" with "required" for each property.
+func NoneOf(names ...string) Constraint[map[string]any] {
+	c := func(data map[string]any) Error {
+		res := Errors{}
+		for _, name := range names {
+			_, found := data[name]
+			if found {
+				res.Add(ErrUnexpected{Name: name})
+			}
+		}
+		return res.Flatten()
+	}
+	not := jsony.Object{{K: "anyOf", V: requiredEach(names)}}
+	return Constraint[map[string]any]{
+		check:  c,
+		fields: jsony.Object{{K: "not", V: not}},
+		props:  names,
+	}
+}
+
+// getField returns the value of the property converted to the given type.
+func getField[T int | float64 | string](data map[string]any, name string) (T, bool) {
+	var zero T
+	raw, found := data[name]
+	if !found {
+		return zero, false
+	}
+	var val any
+	var err Error
+	switch any(zero).(type) {
+	case int:
+		val, err = intValidator(raw)
+	case float64:
+		val, err = float64Validator(raw)
+	case string:
+		val, err = stringValidator(raw)
+	}
+	if err != nil {
+		return zero, false
+	}
+	return val.(T), true
+}
+
+// countFields returns how many of the given properties are present.
+func countFields(data map[string]any, names []string) int {
+	count := 0
+	for _, name := range names {
+		_, found := data[name]
+		if found {
+			count++
+		}
+	}
+	return count
+}
+
+func namesArray(names ...string) jsony.Array[jsony.String] {
+	res := make(jsony.Array[jsony.String], len(names))
+	for i, name := range names {
+		res[i] = jsony.String(name)
+	}
+	return res
+}
+
+// requiredEach generates a list of schemas, each requiring one of the given properties.
+func requiredEach(names []string) jsony.Array[jsony.Object] {
+	res := make(jsony.Array[jsony.Object], len(names))
+	for i, name := range names {
+		res[i] = jsony.Object{{K: "required", V: namesArray(name)}}
+	}
+	return res
+}
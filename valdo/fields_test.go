@@ -0,0 +1,91 @@
+package valdo_test
+
+import (
+	"testing"
+
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestLess(t *testing.T) {
+	t.Parallel()
+	val := valdo.Object(
+		valdo.P("min_price", valdo.Float64()),
+		valdo.P("max_price", valdo.Float64()),
+	).Constrain(valdo.Less[float64]("min_price", "max_price"))
+	noErr(valdo.Validate(val, []byte(`{"min_price": 1, "max_price": 2.5}`)))
+	err := valdo.Validate(val, []byte(`{"min_price": 3, "max_price": 2.5}`))
+	isErr[valdo.ErrProperty](err)
+	isEq(err.Error(), "min_price: must be less than max_price")
+	isEq(valdo.Validate(val, []byte(`{"min_price": 3, "max_price": 3}`)).Error(), "min_price: must be less than max_price")
+	// type errors are reported only by the property validators
+	isEq(valdo.Validate(val, []byte(`{"min_price": "3", "max_price": 1}`)).Error(), "min_price: invalid type: got string, expected number")
+
+	isEq(
+		string(valdo.Schema(val)),
+		`{"type":"object","properties":{"min_price":{"type":"number"},"max_price":{"type":"number"}},"required":["min_price","max_price"],"additionalProperties":false,"x-less":["min_price","max_price"]}`,
+	)
+}
+
+func TestLessOrEqual(t *testing.T) {
+	t.Parallel()
+	val := valdo.Map(valdo.String()).Constrain(valdo.LessOrEqual[string]("from", "to"))
+	noErr(valdo.Validate(val, []byte(`{"from": "2024-01-01", "to": "2024-01-01"}`)))
+	noErr(valdo.Validate(val, []byte(`{"from": "2024-01-01"}`)))
+	err := valdo.Validate(val, []byte(`{"from": "2024-02-01", "to": "2024-01-01"}`))
+	isEq(err.Error(), "from: must be less than or equal to to")
+	translated := valdo.DefaultLocales.Wrap("nl", val)
+	err = valdo.Validate(translated, []byte(`{"from": "2024-02-01", "to": "2024-01-01"}`))
+	isEq(err.Error(), "from: moet kleiner zijn dan of gelijk aan to")
+}
+
+func TestEqualFields(t *testing.T) {
+	t.Parallel()
+	val := valdo.Object(
+		valdo.P("password", valdo.String()),
+		valdo.P("password2", valdo.String()),
+	).Constrain(valdo.EqualFields("password", "password2"))
+	noErr(valdo.Validate(val, []byte(`{"password": "hunter2", "password2": "hunter2"}`)))
+	err := valdo.Validate(val, []byte(`{"password": "hunter2", "password2": "hunter3"}`))
+	isEq(err.Error(), "password2: must be equal to password")
+}
+
+func TestAtLeastOneOf(t *testing.T) {
+	t.Parallel()
+	val := valdo.Map(valdo.String()).Constrain(valdo.AtLeastOneOf("email", "phone"))
+	noErr(valdo.Validate(val, []byte(`{"email": "a@b.c"}`)))
+	noErr(valdo.Validate(val, []byte(`{"email": "a@b.c", "phone": "123"}`)))
+	err := valdo.Validate(val, []byte(`{}`))
+	isErr[valdo.ErrAtLeastOneOf](err)
+	isEq(err.Error(), "at least one of the properties is required: email, phone")
+	isEq(
+		string(valdo.Schema(val)),
+		`{"type":"object","additionalProperties":{"type":"string"},"anyOf":[{"required":["email"]},{"required":["phone"]}]}`,
+	)
+}
+
+func TestExactlyOneOf(t *testing.T) {
+	t.Parallel()
+	val := valdo.Map(valdo.String()).Constrain(
+		valdo.ExactlyOneOf("email", "phone"),
+		valdo.ExactlyOneOf("name", "nick"),
+	)
+	noErr(valdo.Validate(val, []byte(`{"email": "a@b.c", "nick": "x"}`)))
+	isErr[valdo.ErrExactlyOneOf](valdo.Validate(val, []byte(`{"name": "x"}`)))
+	isErr[valdo.ErrExactlyOneOf](valdo.Validate(val, []byte(`{"email": "a@b.c", "phone": "123", "name": "x"}`)))
+	isEq(
+		string(valdo.Schema(val)),
+		`{"type":"object","additionalProperties":{"type":"string"},"oneOf":[{"required":["email"]},{"required":["phone"]}],"allOf":[{"oneOf":[{"required":["name"]},{"required":["nick"]}]}]}`,
+	)
+}
+
+func TestNoneOf(t *testing.T) {
+	t.Parallel()
+	val := valdo.Map(valdo.Any()).Constrain(valdo.NoneOf("id", "created_at"))
+	noErr(valdo.Validate(val, []byte(`{"name": "x"}`)))
+	err := valdo.Validate(val, []byte(`{"id": 1, "name": "x", "created_at": 1}`))
+	isEq(err.Error(), "unexpected property: id; unexpected property: created_at")
+	isEq(
+		string(valdo.Schema(val)),
+		`{"type":"object","additionalProperties":{},"not":{"anyOf":[{"required":["id"]},{"required":["created_at"]}]}}`,
+	)
+}
@@ -2,143 +2,748 @@ package valdo
 
 // Locales registry with all locales supported out-of-the-box.
 var DefaultLocales = Locales{
+	"en": English,
 	"nl": Dutch,
 	"ru": Russian,
 	"de": German,
 	"fr": French,
+	"es": Spanish,
+	"it": Italian,
+	"pt": Portuguese,
+	"pl": Polish,
+	"uk": Ukrainian,
+	"ja": Japanese,
+	"zh": Chinese,
 }
 
 // The original English error mesages, for reference.
 var English = Locale{
-	ErrNoInput{}:       "the input is empty",
-	ErrProperty{}:      "{name}: {error}",
-	ErrIndex{}:         "at {index}: {error}",
-	ErrType{}:          "invalid type: got {got}, expected {expected}",
-	ErrRequired{}:      "{name} is required but not found",
-	ErrUnexpected{}:    "unexpected property: {name}",
-	ErrMultipleOf{}:    "must be a multiple of {value}",
-	ErrConst{}:         `expected the value to be equal to "{expected}"`,
-	ErrNot{}:           "must not match the schema",
-	ErrAnyOf{}:         "must match any of the conditions: {errors}",
-	ErrMin{}:           "must be greater than or equal to {value}",
-	ErrExclMin{}:       "must be greater than {value}",
-	ErrMax{}:           "must be less than or equal to {value}",
-	ErrExclMax{}:       "must be less than {value}",
-	ErrMinLen{}:        "must be at least {value} characters long",
-	ErrMaxLen{}:        "must be at most {value} characters long",
-	ErrPattern{}:       "must match the pattern",
-	ErrContains{}:      "at least one item {error}",
-	ErrMinItems{}:      "must contain at least {value} items",
-	ErrMaxItems{}:      "must contain at most {value} items",
-	ErrPropertyNames{}: "property name {name} {error}",
-	ErrMinProperties{}: "must contain at least {value} properties",
-	ErrMaxProperties{}: "must contain at most {value} properties",
+	ErrNoInput{}:                "the input is empty",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "at {index}: {error}",
+	ErrType{}:                   "invalid type: got {got}, expected {expected}",
+	ErrDecimal{}:                "must be a decimal number",
+	ErrBigInt{}:                 "must be an integer number",
+	ErrRequired{}:               "{name} is required but not found",
+	ErrUnexpected{}:             "unexpected property: {name}",
+	ErrUnexpectedItem{}:         "unexpected item",
+	ErrMultipleOf{}:             "must be a multiple of {value}",
+	ErrConst{}:                  "expected the value to be equal to {expected}",
+	ErrEnum{}:                   "expected the value to be one of: {expected}",
+	ErrNot{}:                    "must not match the schema",
+	ErrAnyOf{}:                  "must match any of the conditions: {errors}",
+	ErrMin{}:                    "must be greater than or equal to {value}",
+	ErrExclMin{}:                "must be greater than {value}",
+	ErrMax{}:                    "must be less than or equal to {value}",
+	ErrExclMax{}:                "must be less than {value}",
+	ErrMinLen{}:                 "must be at least {value, plural, one {# character} other {# characters}} long",
+	ErrMaxLen{}:                 "must be at most {value, plural, one {# character} other {# characters}} long",
+	ErrMinGraphemes{}:           "must be at least {value, plural, one {# character} other {# characters}} long",
+	ErrMaxGraphemes{}:           "must be at most {value, plural, one {# character} other {# characters}} long",
+	ErrMaxBytes{}:               "must be at most {value, plural, one {# byte} other {# bytes}} long",
+	ErrPrecision{}:              "must have at most {value, plural, one {# digit} other {# digits}}",
+	ErrScale{}:                  "must have at most {value, plural, one {# digit} other {# digits}} after the decimal point",
+	ErrPattern{}:                "must match the pattern",
+	ErrContains{}:               "no item matches: {error}",
+	ErrMinItems{}:               "must contain at least {value, plural, one {# item} other {# items}}",
+	ErrMaxItems{}:               "must contain at most {value, plural, one {# item} other {# items}}",
+	ErrPropertyNames{}:          "property name {name} {error}",
+	ErrMinProperties{}:          "must contain at least {value, plural, one {# property} other {# properties}}",
+	ErrMaxProperties{}:          "must contain at most {value, plural, one {# property} other {# properties}}",
+	ErrCheck{}:                  "cannot check the value",
+	ErrNotFound{}:               "{value} is not found",
+	ErrExists{}:                 "{value} already exists",
+	ErrReadOnly{}:               "must not be present in requests",
+	ErrWriteOnly{}:              "must not be present in responses",
+	ErrDeprecated{}:             "is deprecated",
+	ErrLessField{}:              "must be less than {name}",
+	ErrLessOrEqualField{}:       "must be less than or equal to {name}",
+	ErrEqualField{}:             "must be equal to {name}",
+	ErrAtLeastOneOf{}:           "at least one of the properties is required: {names}",
+	ErrExactlyOneOf{}:           "exactly one of the properties is required: {names}",
+	ErrUniqueItems{}:            "must be unique, the same as the item at {index}",
+	ErrMinContains{}:            "must contain at least {value, plural, one {# matching item} other {# matching items}}, found {count}",
+	ErrMaxContains{}:            "must contain at most {value, plural, one {# matching item} other {# matching items}}, found {count}",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "boolean",
+	termKey{"integer"}:          "integer",
+	termKey{"unsigned integer"}: "unsigned integer",
+	termKey{"number"}:           "number",
+	termKey{"string"}:           "string",
+	termKey{"array"}:            "array",
+	termKey{"object"}:           "object",
+	termKey{"unknown type"}:     "unknown type",
+	termKey{"true"}:             "true",
+	termKey{"false"}:            "false",
 }
 
 // Dutch translation of all error messages.
 var Dutch = Locale{
-	ErrNoInput{}:       "de invoer is leeg",
-	ErrProperty{}:      "{name}: {error}",
-	ErrIndex{}:         "bij {index}: {error}",
-	ErrType{}:          "ongeldig type: kreeg {got}, verwachtte {expected}",
-	ErrRequired{}:      "{name} is vereist maar niet gevonden",
-	ErrUnexpected{}:    "onverwachte eigenschap: {name}",
-	ErrMultipleOf{}:    "moet een veelvoud van {value} zijn",
-	ErrConst{}:         `verwachtte dat de waarde gelijk zou zijn aan "{expected}"`,
-	ErrNot{}:           "mag niet overeenkomen met het schema",
-	ErrAnyOf{}:         "moet aan een van de voorwaarden voldoen: {errors}",
-	ErrMin{}:           "moet groter zijn dan of gelijk aan {value}",
-	ErrExclMin{}:       "moet groter zijn dan {value}",
-	ErrMax{}:           "moet kleiner zijn dan of gelijk aan {value}",
-	ErrExclMax{}:       "moet kleiner zijn dan {value}",
-	ErrMinLen{}:        "moet minstens {value} tekens lang zijn",
-	ErrMaxLen{}:        "mag maximaal {value} tekens lang zijn",
-	ErrPattern{}:       "moet overeenkomen met het patroon",
-	ErrContains{}:      "ten minste één item {error}",
-	ErrMinItems{}:      "moet minstens {value} items bevatten",
-	ErrMaxItems{}:      "mag maximaal {value} items bevatten",
-	ErrPropertyNames{}: "eigenschapsnaam {name} {error}",
-	ErrMinProperties{}: "moet minstens {value} eigenschappen bevatten",
-	ErrMaxProperties{}: "mag maximaal {value} eigenschappen bevatten",
+	ErrNoInput{}:                "de invoer is leeg",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "bij {index}: {error}",
+	ErrType{}:                   "ongeldig type: kreeg {got}, verwachtte {expected}",
+	ErrDecimal{}:                "moet een decimaal getal zijn",
+	ErrBigInt{}:                 "moet een geheel getal zijn",
+	ErrRequired{}:               "{name} is vereist maar niet gevonden",
+	ErrUnexpected{}:             "onverwachte eigenschap: {name}",
+	ErrUnexpectedItem{}:         "onverwacht element",
+	ErrMultipleOf{}:             "moet een veelvoud van {value} zijn",
+	ErrConst{}:                  "verwachtte dat de waarde gelijk zou zijn aan {expected}",
+	ErrEnum{}:                   "verwachtte dat de waarde een van de volgende zou zijn: {expected}",
+	ErrNot{}:                    "mag niet overeenkomen met het schema",
+	ErrAnyOf{}:                  "moet aan een van de voorwaarden voldoen: {errors}",
+	ErrMin{}:                    "moet groter zijn dan of gelijk aan {value}",
+	ErrExclMin{}:                "moet groter zijn dan {value}",
+	ErrMax{}:                    "moet kleiner zijn dan of gelijk aan {value}",
+	ErrExclMax{}:                "moet kleiner zijn dan {value}",
+	ErrMinLen{}:                 "moet minstens {value, plural, one {# teken} other {# tekens}} lang zijn",
+	ErrMaxLen{}:                 "mag maximaal {value, plural, one {# teken} other {# tekens}} lang zijn",
+	ErrMinGraphemes{}:           "moet minstens {value, plural, one {# teken} other {# tekens}} lang zijn",
+	ErrMaxGraphemes{}:           "mag maximaal {value, plural, one {# teken} other {# tekens}} lang zijn",
+	ErrMaxBytes{}:               "mag maximaal {value, plural, one {# byte} other {# bytes}} lang zijn",
+	ErrPrecision{}:              "mag maximaal {value, plural, one {# cijfer} other {# cijfers}} hebben",
+	ErrScale{}:                  "mag maximaal {value, plural, one {# cijfer} other {# cijfers}} na de komma hebben",
+	ErrPattern{}:                "moet overeenkomen met het patroon",
+	ErrContains{}:               "geen enkel item komt overeen: {error}",
+	ErrMinItems{}:               "moet minstens {value, plural, one {# item} other {# items}} bevatten",
+	ErrMaxItems{}:               "mag maximaal {value, plural, one {# item} other {# items}} bevatten",
+	ErrPropertyNames{}:          "eigenschapsnaam {name} {error}",
+	ErrMinProperties{}:          "moet minstens {value, plural, one {# eigenschap} other {# eigenschappen}} bevatten",
+	ErrMaxProperties{}:          "mag maximaal {value, plural, one {# eigenschap} other {# eigenschappen}} bevatten",
+	ErrCheck{}:                  "kan de waarde niet controleren",
+	ErrNotFound{}:               "{value} is niet gevonden",
+	ErrExists{}:                 "{value} bestaat al",
+	ErrReadOnly{}:               "mag niet aanwezig zijn in verzoeken",
+	ErrWriteOnly{}:              "mag niet aanwezig zijn in antwoorden",
+	ErrDeprecated{}:             "is verouderd",
+	ErrLessField{}:              "moet kleiner zijn dan {name}",
+	ErrLessOrEqualField{}:       "moet kleiner zijn dan of gelijk aan {name}",
+	ErrEqualField{}:             "moet gelijk zijn aan {name}",
+	ErrAtLeastOneOf{}:           "ten minste één van de eigenschappen is vereist: {names}",
+	ErrExactlyOneOf{}:           "precies één van de eigenschappen is vereist: {names}",
+	ErrUniqueItems{}:            "moet uniek zijn, gelijk aan het item bij {index}",
+	ErrMinContains{}:            "moet minstens {value, plural, one {# overeenkomend item} other {# overeenkomende items}} bevatten, gevonden: {count}",
+	ErrMaxContains{}:            "mag maximaal {value, plural, one {# overeenkomend item} other {# overeenkomende items}} bevatten, gevonden: {count}",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "boolean",
+	termKey{"integer"}:          "geheel getal",
+	termKey{"unsigned integer"}: "natuurlijk getal",
+	termKey{"number"}:           "getal",
+	termKey{"string"}:           "tekenreeks",
+	termKey{"array"}:            "lijst",
+	termKey{"object"}:           "object",
+	termKey{"unknown type"}:     "onbekend type",
+	termKey{"true"}:             "waar",
+	termKey{"false"}:            "onwaar",
 }
 
 // Russian translation of all error messages.
 var Russian = Locale{
-	ErrNoInput{}:       "ввод пуст",
-	ErrProperty{}:      "{name}: {error}",
-	ErrIndex{}:         "на {index}: {error}",
-	ErrType{}:          "неверный тип: получено {got}, ожидалось {expected}",
-	ErrRequired{}:      "{name} обязателен, но не найден",
-	ErrUnexpected{}:    "неожиданное свойство: {name}",
-	ErrMultipleOf{}:    "должно быть кратным {value}",
-	ErrConst{}:         `значение должно быть равно "{expected}"`,
-	ErrNot{}:           "не должно соответствовать схеме",
-	ErrAnyOf{}:         "должно соответствовать одному из условий: {errors}",
-	ErrMin{}:           "должно быть больше или равно {value}",
-	ErrExclMin{}:       "должно быть больше {value}",
-	ErrMax{}:           "должно быть меньше или равно {value}",
-	ErrExclMax{}:       "должно быть меньше {value}",
-	ErrMinLen{}:        "должно содержать как минимум {value} символов",
-	ErrMaxLen{}:        "должно содержать не более {value} символов",
-	ErrPattern{}:       "должно соответствовать шаблону",
-	ErrContains{}:      "как минимум один элемент {error}",
-	ErrMinItems{}:      "должно содержать как минимум {value} элементов",
-	ErrMaxItems{}:      "должно содержать не более {value} элементов",
-	ErrPropertyNames{}: "имя свойства {name} {error}",
-	ErrMinProperties{}: "должно содержать как минимум {value} свойств",
-	ErrMaxProperties{}: "должно содержать не более {value} свойств",
+	ErrNoInput{}:                "ввод пуст",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "на {index}: {error}",
+	ErrType{}:                   "неверный тип: получено {got}, ожидалось {expected}",
+	ErrDecimal{}:                "должно быть десятичным числом",
+	ErrBigInt{}:                 "должно быть целым числом",
+	ErrRequired{}:               "{name} обязателен, но не найден",
+	ErrUnexpected{}:             "неожиданное свойство: {name}",
+	ErrUnexpectedItem{}:         "неожиданный элемент",
+	ErrMultipleOf{}:             "должно быть кратным {value}",
+	ErrConst{}:                  "значение должно быть равно {expected}",
+	ErrEnum{}:                   "значение должно быть одним из: {expected}",
+	ErrNot{}:                    "не должно соответствовать схеме",
+	ErrAnyOf{}:                  "должно соответствовать одному из условий: {errors}",
+	ErrMin{}:                    "должно быть больше или равно {value}",
+	ErrExclMin{}:                "должно быть больше {value}",
+	ErrMax{}:                    "должно быть меньше или равно {value}",
+	ErrExclMax{}:                "должно быть меньше {value}",
+	ErrMinLen{}:                 "должно содержать как минимум {value, plural, one {# символ} few {# символа} many {# символов} other {# символа}}",
+	ErrMaxLen{}:                 "должно содержать не более {value, plural, one {# символа} few {# символов} many {# символов} other {# символа}}",
+	ErrMinGraphemes{}:           "должно содержать как минимум {value, plural, one {# символ} few {# символа} many {# символов} other {# символа}}",
+	ErrMaxGraphemes{}:           "должно содержать не более {value, plural, one {# символа} few {# символов} many {# символов} other {# символа}}",
+	ErrMaxBytes{}:               "должно занимать не более {value, plural, one {# байта} few {# байтов} many {# байтов} other {# байта}}",
+	ErrPrecision{}:              "должно содержать не более {value, plural, one {# цифры} few {# цифр} many {# цифр} other {# цифры}}",
+	ErrScale{}:                  "должно содержать не более {value, plural, one {# цифры} few {# цифр} many {# цифр} other {# цифры}} после запятой",
+	ErrPattern{}:                "должно соответствовать шаблону",
+	ErrContains{}:               "ни один элемент не подходит: {error}",
+	ErrMinItems{}:               "должно содержать как минимум {value, plural, one {# элемент} few {# элемента} many {# элементов} other {# элемента}}",
+	ErrMaxItems{}:               "должно содержать не более {value, plural, one {# элемента} few {# элементов} many {# элементов} other {# элемента}}",
+	ErrPropertyNames{}:          "имя свойства {name} {error}",
+	ErrMinProperties{}:          "должно содержать как минимум {value, plural, one {# свойство} few {# свойства} many {# свойств} other {# свойства}}",
+	ErrMaxProperties{}:          "должно содержать не более {value, plural, one {# свойства} few {# свойств} many {# свойств} other {# свойства}}",
+	ErrCheck{}:                  "не удалось проверить значение",
+	ErrNotFound{}:               "{value} не найдено",
+	ErrExists{}:                 "{value} уже существует",
+	ErrReadOnly{}:               "не должно присутствовать в запросах",
+	ErrWriteOnly{}:              "не должно присутствовать в ответах",
+	ErrDeprecated{}:             "устарело",
+	ErrLessField{}:              "должно быть меньше, чем {name}",
+	ErrLessOrEqualField{}:       "должно быть меньше или равно {name}",
+	ErrEqualField{}:             "должно совпадать с {name}",
+	ErrAtLeastOneOf{}:           "требуется хотя бы одно из свойств: {names}",
+	ErrExactlyOneOf{}:           "требуется ровно одно из свойств: {names}",
+	ErrUniqueItems{}:            "должно быть уникальным, совпадает с элементом {index}",
+	ErrMinContains{}:            "должно содержать как минимум {value, plural, one {# подходящий элемент} few {# подходящих элемента} many {# подходящих элементов} other {# подходящего элемента}}, найдено: {count}",
+	ErrMaxContains{}:            "должно содержать не более {value, plural, one {# подходящего элемента} few {# подходящих элементов} many {# подходящих элементов} other {# подходящего элемента}}, найдено: {count}",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "логическое значение",
+	termKey{"integer"}:          "целое число",
+	termKey{"unsigned integer"}: "натуральное число",
+	termKey{"number"}:           "число",
+	termKey{"string"}:           "строка",
+	termKey{"array"}:            "массив",
+	termKey{"object"}:           "объект",
+	termKey{"unknown type"}:     "неизвестный тип",
+	termKey{"true"}:             "истина",
+	termKey{"false"}:            "ложь",
 }
 
 // German translation of all error messages.
 var German = Locale{
-	ErrNoInput{}:       "Die Eingabe ist leer",
-	ErrProperty{}:      "{name}: {error}",
-	ErrIndex{}:         "bei {index}: {error}",
-	ErrType{}:          "Ungültiger Typ: erhalten {got}, erwartet {expected}",
-	ErrRequired{}:      "{name} ist erforderlich, wurde aber nicht gefunden",
-	ErrUnexpected{}:    "Unerwartete Eigenschaft: {name}",
-	ErrMultipleOf{}:    "Muss ein Vielfaches von {value} sein",
-	ErrConst{}:         `erwartet, dass der Wert gleich "{expected}" ist`,
-	ErrNot{}:           "Darf nicht dem Schema entsprechen",
-	ErrAnyOf{}:         "muss eine der Bedingungen erfüllen: {errors}",
-	ErrMin{}:           "Muss größer oder gleich {value} sein",
-	ErrExclMin{}:       "Muss größer als {value} sein",
-	ErrMax{}:           "Muss kleiner oder gleich {value} sein",
-	ErrExclMax{}:       "Muss kleiner als {value} sein",
-	ErrMinLen{}:        "Muss mindestens {value} Zeichen lang sein",
-	ErrMaxLen{}:        "Darf höchstens {value} Zeichen lang sein",
-	ErrPattern{}:       "Muss dem Muster entsprechen",
-	ErrContains{}:      "Mindestens ein Element {error}",
-	ErrMinItems{}:      "Muss mindestens {value} Elemente enthalten",
-	ErrMaxItems{}:      "Darf höchstens {value} Elemente enthalten",
-	ErrPropertyNames{}: "Eigenschaftsname {name} {error}",
-	ErrMinProperties{}: "Muss mindestens {value} Eigenschaften enthalten",
-	ErrMaxProperties{}: "Darf höchstens {value} Eigenschaften enthalten",
+	ErrNoInput{}:                "Die Eingabe ist leer",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "bei {index}: {error}",
+	ErrType{}:                   "Ungültiger Typ: erhalten {got}, erwartet {expected}",
+	ErrDecimal{}:                "Muss eine Dezimalzahl sein",
+	ErrBigInt{}:                 "Muss eine ganze Zahl sein",
+	ErrRequired{}:               "{name} ist erforderlich, wurde aber nicht gefunden",
+	ErrUnexpected{}:             "Unerwartete Eigenschaft: {name}",
+	ErrUnexpectedItem{}:         "Unerwartetes Element",
+	ErrMultipleOf{}:             "Muss ein Vielfaches von {value} sein",
+	ErrConst{}:                  "erwartet, dass der Wert gleich {expected} ist",
+	ErrEnum{}:                   "erwartet, dass der Wert einer der folgenden ist: {expected}",
+	ErrNot{}:                    "Darf nicht dem Schema entsprechen",
+	ErrAnyOf{}:                  "muss eine der Bedingungen erfüllen: {errors}",
+	ErrMin{}:                    "Muss größer oder gleich {value} sein",
+	ErrExclMin{}:                "Muss größer als {value} sein",
+	ErrMax{}:                    "Muss kleiner oder gleich {value} sein",
+	ErrExclMax{}:                "Muss kleiner als {value} sein",
+	ErrMinLen{}:                 "Muss mindestens {value} Zeichen lang sein",
+	ErrMaxLen{}:                 "Darf höchstens {value} Zeichen lang sein",
+	ErrMinGraphemes{}:           "Muss mindestens {value} Zeichen lang sein",
+	ErrMaxGraphemes{}:           "Darf höchstens {value} Zeichen lang sein",
+	ErrMaxBytes{}:               "Darf höchstens {value, plural, one {# Byte} other {# Bytes}} lang sein",
+	ErrPrecision{}:              "Darf höchstens {value, plural, one {# Ziffer} other {# Ziffern}} haben",
+	ErrScale{}:                  "Darf höchstens {value, plural, one {# Nachkommastelle} other {# Nachkommastellen}} haben",
+	ErrPattern{}:                "Muss dem Muster entsprechen",
+	ErrContains{}:               "Kein Element passt: {error}",
+	ErrMinItems{}:               "Muss mindestens {value, plural, one {# Element} other {# Elemente}} enthalten",
+	ErrMaxItems{}:               "Darf höchstens {value, plural, one {# Element} other {# Elemente}} enthalten",
+	ErrPropertyNames{}:          "Eigenschaftsname {name} {error}",
+	ErrMinProperties{}:          "Muss mindestens {value, plural, one {# Eigenschaft} other {# Eigenschaften}} enthalten",
+	ErrMaxProperties{}:          "Darf höchstens {value, plural, one {# Eigenschaft} other {# Eigenschaften}} enthalten",
+	ErrCheck{}:                  "Wert kann nicht geprüft werden",
+	ErrNotFound{}:               "{value} wurde nicht gefunden",
+	ErrExists{}:                 "{value} existiert bereits",
+	ErrReadOnly{}:               "Darf in Anfragen nicht vorhanden sein",
+	ErrWriteOnly{}:              "Darf in Antworten nicht vorhanden sein",
+	ErrDeprecated{}:             "Ist veraltet",
+	ErrLessField{}:              "muss kleiner als {name} sein",
+	ErrLessOrEqualField{}:       "muss kleiner oder gleich {name} sein",
+	ErrEqualField{}:             "muss gleich {name} sein",
+	ErrAtLeastOneOf{}:           "mindestens eine der Eigenschaften ist erforderlich: {names}",
+	ErrExactlyOneOf{}:           "genau eine der Eigenschaften ist erforderlich: {names}",
+	ErrUniqueItems{}:            "muss eindeutig sein, gleich dem Element bei {index}",
+	ErrMinContains{}:            "Muss mindestens {value, plural, one {# passendes Element} other {# passende Elemente}} enthalten, gefunden: {count}",
+	ErrMaxContains{}:            "Darf höchstens {value, plural, one {# passendes Element} other {# passende Elemente}} enthalten, gefunden: {count}",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "Boolescher Wert",
+	termKey{"integer"}:          "Ganzzahl",
+	termKey{"unsigned integer"}: "natürliche Zahl",
+	termKey{"number"}:           "Zahl",
+	termKey{"string"}:           "Zeichenkette",
+	termKey{"array"}:            "Array",
+	termKey{"object"}:           "Objekt",
+	termKey{"unknown type"}:     "unbekannter Typ",
+	termKey{"true"}:             "wahr",
+	termKey{"false"}:            "falsch",
 }
 
 // French translation of all error messages.
 var French = Locale{
-	ErrNoInput{}:       "l'entrée est vide",
-	ErrProperty{}:      "{name}: {error}",
-	ErrIndex{}:         "à {index}: {error}",
-	ErrType{}:          "type invalide : reçu {got}, attendu {expected}",
-	ErrRequired{}:      "{name} est requis mais non trouvé",
-	ErrUnexpected{}:    "propriété inattendue : {name}",
-	ErrMultipleOf{}:    "doit être un multiple de {value}",
-	ErrConst{}:         "on s'attendait à ce que la valeur soit égale à «{expected}»",
-	ErrNot{}:           "ne doit pas correspondre au schéma",
-	ErrAnyOf{}:         "doit correspondre à l'une des conditions : {errors}",
-	ErrMin{}:           "doit être supérieur ou égal à {value}",
-	ErrExclMin{}:       "doit être supérieur à {value}",
-	ErrMax{}:           "doit être inférieur ou égal à {value}",
-	ErrExclMax{}:       "doit être inférieur à {value}",
-	ErrMinLen{}:        "doit contenir au moins {value} caractères",
-	ErrMaxLen{}:        "doit contenir au maximum {value} caractères",
-	ErrPattern{}:       "doit correspondre au modèle",
-	ErrContains{}:      "au moins un élément {error}",
-	ErrMinItems{}:      "doit contenir au moins {value} éléments",
-	ErrMaxItems{}:      "doit contenir au maximum {value} éléments",
-	ErrPropertyNames{}: "nom de la propriété {name} {error}",
-	ErrMinProperties{}: "doit contenir au moins {value} propriétés",
-	ErrMaxProperties{}: "doit contenir au maximum {value} propriétés",
+	ErrNoInput{}:                "l'entrée est vide",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "à {index}: {error}",
+	ErrType{}:                   "type invalide : reçu {got}, attendu {expected}",
+	ErrDecimal{}:                "doit être un nombre décimal",
+	ErrBigInt{}:                 "doit être un nombre entier",
+	ErrRequired{}:               "{name} est requis mais non trouvé",
+	ErrUnexpected{}:             "propriété inattendue : {name}",
+	ErrUnexpectedItem{}:         "élément inattendu",
+	ErrMultipleOf{}:             "doit être un multiple de {value}",
+	ErrConst{}:                  "on s'attendait à ce que la valeur soit égale à {expected}",
+	ErrEnum{}:                   "on s'attendait à ce que la valeur soit l'une des suivantes : {expected}",
+	ErrNot{}:                    "ne doit pas correspondre au schéma",
+	ErrAnyOf{}:                  "doit correspondre à l'une des conditions : {errors}",
+	ErrMin{}:                    "doit être supérieur ou égal à {value}",
+	ErrExclMin{}:                "doit être supérieur à {value}",
+	ErrMax{}:                    "doit être inférieur ou égal à {value}",
+	ErrExclMax{}:                "doit être inférieur à {value}",
+	ErrMinLen{}:                 "doit contenir au moins {value, plural, one {# caractère} other {# caractères}}",
+	ErrMaxLen{}:                 "doit contenir au maximum {value, plural, one {# caractère} other {# caractères}}",
+	ErrMinGraphemes{}:           "doit contenir au moins {value, plural, one {# caractère} other {# caractères}}",
+	ErrMaxGraphemes{}:           "doit contenir au maximum {value, plural, one {# caractère} other {# caractères}}",
+	ErrMaxBytes{}:               "doit contenir au maximum {value, plural, one {# octet} other {# octets}}",
+	ErrPrecision{}:              "doit contenir au maximum {value, plural, one {# chiffre} other {# chiffres}}",
+	ErrScale{}:                  "doit contenir au maximum {value, plural, one {# chiffre} other {# chiffres}} après la virgule",
+	ErrPattern{}:                "doit correspondre au modèle",
+	ErrContains{}:               "aucun élément ne correspond : {error}",
+	ErrMinItems{}:               "doit contenir au moins {value, plural, one {# élément} other {# éléments}}",
+	ErrMaxItems{}:               "doit contenir au maximum {value, plural, one {# élément} other {# éléments}}",
+	ErrPropertyNames{}:          "nom de la propriété {name} {error}",
+	ErrMinProperties{}:          "doit contenir au moins {value, plural, one {# propriété} other {# propriétés}}",
+	ErrMaxProperties{}:          "doit contenir au maximum {value, plural, one {# propriété} other {# propriétés}}",
+	ErrCheck{}:                  "impossible de vérifier la valeur",
+	ErrNotFound{}:               "{value} est introuvable",
+	ErrExists{}:                 "{value} existe déjà",
+	ErrReadOnly{}:               "ne doit pas être présent dans les requêtes",
+	ErrWriteOnly{}:              "ne doit pas être présent dans les réponses",
+	ErrDeprecated{}:             "est obsolète",
+	ErrLessField{}:              "doit être inférieur à {name}",
+	ErrLessOrEqualField{}:       "doit être inférieur ou égal à {name}",
+	ErrEqualField{}:             "doit être égal à {name}",
+	ErrAtLeastOneOf{}:           "au moins une des propriétés est requise : {names}",
+	ErrExactlyOneOf{}:           "exactement une des propriétés est requise : {names}",
+	ErrUniqueItems{}:            "doit être unique, identique à l'élément à {index}",
+	ErrMinContains{}:            "doit contenir au moins {value, plural, one {# élément correspondant} other {# éléments correspondants}}, trouvés : {count}",
+	ErrMaxContains{}:            "doit contenir au maximum {value, plural, one {# élément correspondant} other {# éléments correspondants}}, trouvés : {count}",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "booléen",
+	termKey{"integer"}:          "entier",
+	termKey{"unsigned integer"}: "entier non signé",
+	termKey{"number"}:           "nombre",
+	termKey{"string"}:           "chaîne",
+	termKey{"array"}:            "tableau",
+	termKey{"object"}:           "objet",
+	termKey{"unknown type"}:     "type inconnu",
+	termKey{"true"}:             "vrai",
+	termKey{"false"}:            "faux",
+}
+
+// Spanish translation of all error messages.
+var Spanish = Locale{
+	ErrNoInput{}:                "la entrada está vacía",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "en {index}: {error}",
+	ErrType{}:                   "tipo no válido: se obtuvo {got}, se esperaba {expected}",
+	ErrDecimal{}:                "debe ser un número decimal",
+	ErrBigInt{}:                 "debe ser un número entero",
+	ErrRequired{}:               "{name} es obligatorio pero no se encontró",
+	ErrUnexpected{}:             "propiedad inesperada: {name}",
+	ErrUnexpectedItem{}:         "elemento inesperado",
+	ErrMultipleOf{}:             "debe ser múltiplo de {value}",
+	ErrConst{}:                  "se esperaba que el valor fuera igual a {expected}",
+	ErrEnum{}:                   "se esperaba que el valor fuera uno de: {expected}",
+	ErrNot{}:                    "no debe coincidir con el esquema",
+	ErrAnyOf{}:                  "debe cumplir alguna de las condiciones: {errors}",
+	ErrMin{}:                    "debe ser mayor o igual que {value}",
+	ErrExclMin{}:                "debe ser mayor que {value}",
+	ErrMax{}:                    "debe ser menor o igual que {value}",
+	ErrExclMax{}:                "debe ser menor que {value}",
+	ErrMinLen{}:                 "debe tener al menos {value, plural, one {# carácter} other {# caracteres}}",
+	ErrMaxLen{}:                 "debe tener como máximo {value, plural, one {# carácter} other {# caracteres}}",
+	ErrMinGraphemes{}:           "debe tener al menos {value, plural, one {# carácter} other {# caracteres}}",
+	ErrMaxGraphemes{}:           "debe tener como máximo {value, plural, one {# carácter} other {# caracteres}}",
+	ErrMaxBytes{}:               "debe tener como máximo {value, plural, one {# byte} other {# bytes}}",
+	ErrPrecision{}:              "debe tener como máximo {value, plural, one {# dígito} other {# dígitos}}",
+	ErrScale{}:                  "debe tener como máximo {value, plural, one {# dígito} other {# dígitos}} decimales",
+	ErrPattern{}:                "debe coincidir con el patrón",
+	ErrContains{}:               "ningún elemento coincide: {error}",
+	ErrMinItems{}:               "debe contener al menos {value, plural, one {# elemento} other {# elementos}}",
+	ErrMaxItems{}:               "debe contener como máximo {value, plural, one {# elemento} other {# elementos}}",
+	ErrPropertyNames{}:          "nombre de propiedad {name} {error}",
+	ErrMinProperties{}:          "debe contener al menos {value, plural, one {# propiedad} other {# propiedades}}",
+	ErrMaxProperties{}:          "debe contener como máximo {value, plural, one {# propiedad} other {# propiedades}}",
+	ErrCheck{}:                  "no se puede comprobar el valor",
+	ErrNotFound{}:               "{value} no se encuentra",
+	ErrExists{}:                 "{value} ya existe",
+	ErrReadOnly{}:               "no debe estar presente en las solicitudes",
+	ErrWriteOnly{}:              "no debe estar presente en las respuestas",
+	ErrDeprecated{}:             "está obsoleto",
+	ErrLessField{}:              "debe ser menor que {name}",
+	ErrLessOrEqualField{}:       "debe ser menor o igual que {name}",
+	ErrEqualField{}:             "debe ser igual a {name}",
+	ErrAtLeastOneOf{}:           "se requiere al menos una de las propiedades: {names}",
+	ErrExactlyOneOf{}:           "se requiere exactamente una de las propiedades: {names}",
+	ErrUniqueItems{}:            "debe ser único, igual al elemento en {index}",
+	ErrMinContains{}:            "debe contener al menos {value, plural, one {# elemento coincidente} other {# elementos coincidentes}}, encontrados: {count}",
+	ErrMaxContains{}:            "debe contener como máximo {value, plural, one {# elemento coincidente} other {# elementos coincidentes}}, encontrados: {count}",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "booleano",
+	termKey{"integer"}:          "entero",
+	termKey{"unsigned integer"}: "entero sin signo",
+	termKey{"number"}:           "número",
+	termKey{"string"}:           "cadena",
+	termKey{"array"}:            "arreglo",
+	termKey{"object"}:           "objeto",
+	termKey{"unknown type"}:     "tipo desconocido",
+	termKey{"true"}:             "verdadero",
+	termKey{"false"}:            "falso",
+}
+
+// Italian translation of all error messages.
+var Italian = Locale{
+	ErrNoInput{}:                "l'input è vuoto",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "in {index}: {error}",
+	ErrType{}:                   "tipo non valido: ricevuto {got}, atteso {expected}",
+	ErrDecimal{}:                "deve essere un numero decimale",
+	ErrBigInt{}:                 "deve essere un numero intero",
+	ErrRequired{}:               "{name} è obbligatorio ma non è stato trovato",
+	ErrUnexpected{}:             "proprietà inattesa: {name}",
+	ErrUnexpectedItem{}:         "elemento inatteso",
+	ErrMultipleOf{}:             "deve essere un multiplo di {value}",
+	ErrConst{}:                  "il valore deve essere uguale a {expected}",
+	ErrEnum{}:                   "il valore deve essere uno tra: {expected}",
+	ErrNot{}:                    "non deve corrispondere allo schema",
+	ErrAnyOf{}:                  "deve soddisfare una delle condizioni: {errors}",
+	ErrMin{}:                    "deve essere maggiore o uguale a {value}",
+	ErrExclMin{}:                "deve essere maggiore di {value}",
+	ErrMax{}:                    "deve essere minore o uguale a {value}",
+	ErrExclMax{}:                "deve essere minore di {value}",
+	ErrMinLen{}:                 "deve contenere almeno {value, plural, one {# carattere} other {# caratteri}}",
+	ErrMaxLen{}:                 "deve contenere al massimo {value, plural, one {# carattere} other {# caratteri}}",
+	ErrMinGraphemes{}:           "deve contenere almeno {value, plural, one {# carattere} other {# caratteri}}",
+	ErrMaxGraphemes{}:           "deve contenere al massimo {value, plural, one {# carattere} other {# caratteri}}",
+	ErrMaxBytes{}:               "deve contenere al massimo {value, plural, one {# byte} other {# byte}}",
+	ErrPrecision{}:              "deve contenere al massimo {value, plural, one {# cifra} other {# cifre}}",
+	ErrScale{}:                  "deve contenere al massimo {value, plural, one {# cifra} other {# cifre}} decimali",
+	ErrPattern{}:                "deve corrispondere al modello",
+	ErrContains{}:               "nessun elemento corrisponde: {error}",
+	ErrMinItems{}:               "deve contenere almeno {value, plural, one {# elemento} other {# elementi}}",
+	ErrMaxItems{}:               "deve contenere al massimo {value, plural, one {# elemento} other {# elementi}}",
+	ErrPropertyNames{}:          "nome della proprietà {name} {error}",
+	ErrMinProperties{}:          "deve contenere almeno {value} proprietà",
+	ErrMaxProperties{}:          "deve contenere al massimo {value} proprietà",
+	ErrCheck{}:                  "impossibile verificare il valore",
+	ErrNotFound{}:               "{value} non è stato trovato",
+	ErrExists{}:                 "{value} esiste già",
+	ErrReadOnly{}:               "non deve essere presente nelle richieste",
+	ErrWriteOnly{}:              "non deve essere presente nelle risposte",
+	ErrDeprecated{}:             "è deprecato",
+	ErrLessField{}:              "deve essere minore di {name}",
+	ErrLessOrEqualField{}:       "deve essere minore o uguale a {name}",
+	ErrEqualField{}:             "deve essere uguale a {name}",
+	ErrAtLeastOneOf{}:           "è richiesta almeno una delle proprietà: {names}",
+	ErrExactlyOneOf{}:           "è richiesta esattamente una delle proprietà: {names}",
+	ErrUniqueItems{}:            "deve essere unico, uguale all'elemento in {index}",
+	ErrMinContains{}:            "deve contenere almeno {value, plural, one {# elemento corrispondente} other {# elementi corrispondenti}}, trovati: {count}",
+	ErrMaxContains{}:            "deve contenere al massimo {value, plural, one {# elemento corrispondente} other {# elementi corrispondenti}}, trovati: {count}",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "booleano",
+	termKey{"integer"}:          "intero",
+	termKey{"unsigned integer"}: "intero senza segno",
+	termKey{"number"}:           "numero",
+	termKey{"string"}:           "stringa",
+	termKey{"array"}:            "array",
+	termKey{"object"}:           "oggetto",
+	termKey{"unknown type"}:     "tipo sconosciuto",
+	termKey{"true"}:             "vero",
+	termKey{"false"}:            "falso",
+}
+
+// Portuguese translation of all error messages.
+var Portuguese = Locale{
+	ErrNoInput{}:                "a entrada está vazia",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "em {index}: {error}",
+	ErrType{}:                   "tipo inválido: recebido {got}, esperado {expected}",
+	ErrDecimal{}:                "deve ser um número decimal",
+	ErrBigInt{}:                 "deve ser um número inteiro",
+	ErrRequired{}:               "{name} é obrigatório, mas não foi encontrado",
+	ErrUnexpected{}:             "propriedade inesperada: {name}",
+	ErrUnexpectedItem{}:         "item inesperado",
+	ErrMultipleOf{}:             "deve ser um múltiplo de {value}",
+	ErrConst{}:                  "o valor deve ser igual a {expected}",
+	ErrEnum{}:                   "o valor deve ser um de: {expected}",
+	ErrNot{}:                    "não deve corresponder ao esquema",
+	ErrAnyOf{}:                  "deve atender a uma das condições: {errors}",
+	ErrMin{}:                    "deve ser maior ou igual a {value}",
+	ErrExclMin{}:                "deve ser maior que {value}",
+	ErrMax{}:                    "deve ser menor ou igual a {value}",
+	ErrExclMax{}:                "deve ser menor que {value}",
+	ErrMinLen{}:                 "deve ter pelo menos {value, plural, one {# caractere} other {# caracteres}}",
+	ErrMaxLen{}:                 "deve ter no máximo {value, plural, one {# caractere} other {# caracteres}}",
+	ErrMinGraphemes{}:           "deve ter pelo menos {value, plural, one {# caractere} other {# caracteres}}",
+	ErrMaxGraphemes{}:           "deve ter no máximo {value, plural, one {# caractere} other {# caracteres}}",
+	ErrMaxBytes{}:               "deve ter no máximo {value, plural, one {# byte} other {# bytes}}",
+	ErrPrecision{}:              "deve ter no máximo {value, plural, one {# dígito} other {# dígitos}}",
+	ErrScale{}:                  "deve ter no máximo {value, plural, one {# casa decimal} other {# casas decimais}}",
+	ErrPattern{}:                "deve corresponder ao padrão",
+	ErrContains{}:               "nenhum item corresponde: {error}",
+	ErrMinItems{}:               "deve conter pelo menos {value, plural, one {# item} other {# itens}}",
+	ErrMaxItems{}:               "deve conter no máximo {value, plural, one {# item} other {# itens}}",
+	ErrPropertyNames{}:          "nome da propriedade {name} {error}",
+	ErrMinProperties{}:          "deve conter pelo menos {value, plural, one {# propriedade} other {# propriedades}}",
+	ErrMaxProperties{}:          "deve conter no máximo {value, plural, one {# propriedade} other {# propriedades}}",
+	ErrCheck{}:                  "não é possível verificar o valor",
+	ErrNotFound{}:               "{value} não foi encontrado",
+	ErrExists{}:                 "{value} já existe",
+	ErrReadOnly{}:               "não deve estar presente nas requisições",
+	ErrWriteOnly{}:              "não deve estar presente nas respostas",
+	ErrDeprecated{}:             "está obsoleto",
+	ErrLessField{}:              "deve ser menor que {name}",
+	ErrLessOrEqualField{}:       "deve ser menor ou igual a {name}",
+	ErrEqualField{}:             "deve ser igual a {name}",
+	ErrAtLeastOneOf{}:           "pelo menos uma das propriedades é obrigatória: {names}",
+	ErrExactlyOneOf{}:           "exatamente uma das propriedades é obrigatória: {names}",
+	ErrUniqueItems{}:            "deve ser único, igual ao item em {index}",
+	ErrMinContains{}:            "deve conter pelo menos {value, plural, one {# item correspondente} other {# itens correspondentes}}, encontrados: {count}",
+	ErrMaxContains{}:            "deve conter no máximo {value, plural, one {# item correspondente} other {# itens correspondentes}}, encontrados: {count}",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "booleano",
+	termKey{"integer"}:          "inteiro",
+	termKey{"unsigned integer"}: "inteiro sem sinal",
+	termKey{"number"}:           "número",
+	termKey{"string"}:           "string",
+	termKey{"array"}:            "array",
+	termKey{"object"}:           "objeto",
+	termKey{"unknown type"}:     "tipo desconhecido",
+	termKey{"true"}:             "verdadeiro",
+	termKey{"false"}:            "falso",
+}
+
+// Polish translation of all error messages.
+var Polish = Locale{
+	ErrNoInput{}:                "dane wejściowe są puste",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "na pozycji {index}: {error}",
+	ErrType{}:                   "nieprawidłowy typ: otrzymano {got}, oczekiwano {expected}",
+	ErrDecimal{}:                "musi być liczbą dziesiętną",
+	ErrBigInt{}:                 "musi być liczbą całkowitą",
+	ErrRequired{}:               "{name} jest wymagane, ale nie zostało znalezione",
+	ErrUnexpected{}:             "nieoczekiwana właściwość: {name}",
+	ErrUnexpectedItem{}:         "nieoczekiwany element",
+	ErrMultipleOf{}:             "musi być wielokrotnością {value}",
+	ErrConst{}:                  "wartość musi być równa {expected}",
+	ErrEnum{}:                   "wartość musi być jedną z: {expected}",
+	ErrNot{}:                    "nie może pasować do schematu",
+	ErrAnyOf{}:                  "musi spełniać jeden z warunków: {errors}",
+	ErrMin{}:                    "musi być większe lub równe {value}",
+	ErrExclMin{}:                "musi być większe niż {value}",
+	ErrMax{}:                    "musi być mniejsze lub równe {value}",
+	ErrExclMax{}:                "musi być mniejsze niż {value}",
+	ErrMinLen{}:                 "musi mieć co najmniej {value, plural, one {# znak} few {# znaki} many {# znaków} other {# znaku}}",
+	ErrMaxLen{}:                 "może mieć co najwyżej {value, plural, one {# znak} few {# znaki} many {# znaków} other {# znaku}}",
+	ErrMinGraphemes{}:           "musi mieć co najmniej {value, plural, one {# znak} few {# znaki} many {# znaków} other {# znaku}}",
+	ErrMaxGraphemes{}:           "może mieć co najwyżej {value, plural, one {# znak} few {# znaki} many {# znaków} other {# znaku}}",
+	ErrMaxBytes{}:               "może mieć co najwyżej {value, plural, one {# bajt} few {# bajty} many {# bajtów} other {# bajta}}",
+	ErrPrecision{}:              "może mieć co najwyżej {value, plural, one {# cyfrę} few {# cyfry} many {# cyfr} other {# cyfry}}",
+	ErrScale{}:                  "może mieć co najwyżej {value, plural, one {# cyfrę} few {# cyfry} many {# cyfr} other {# cyfry}} po przecinku",
+	ErrPattern{}:                "musi pasować do wzorca",
+	ErrContains{}:               "żaden element nie pasuje: {error}",
+	ErrMinItems{}:               "musi zawierać co najmniej {value, plural, one {# element} few {# elementy} many {# elementów} other {# elementu}}",
+	ErrMaxItems{}:               "może zawierać co najwyżej {value, plural, one {# element} few {# elementy} many {# elementów} other {# elementu}}",
+	ErrPropertyNames{}:          "nazwa właściwości {name} {error}",
+	ErrMinProperties{}:          "musi zawierać co najmniej {value, plural, one {# właściwość} other {# właściwości}}",
+	ErrMaxProperties{}:          "może zawierać co najwyżej {value, plural, one {# właściwość} other {# właściwości}}",
+	ErrCheck{}:                  "nie można sprawdzić wartości",
+	ErrNotFound{}:               "nie znaleziono {value}",
+	ErrExists{}:                 "{value} już istnieje",
+	ErrReadOnly{}:               "nie może występować w żądaniach",
+	ErrWriteOnly{}:              "nie może występować w odpowiedziach",
+	ErrDeprecated{}:             "jest przestarzałe",
+	ErrLessField{}:              "musi być mniejsze niż {name}",
+	ErrLessOrEqualField{}:       "musi być mniejsze lub równe {name}",
+	ErrEqualField{}:             "musi być równe {name}",
+	ErrAtLeastOneOf{}:           "wymagana jest co najmniej jedna z właściwości: {names}",
+	ErrExactlyOneOf{}:           "wymagana jest dokładnie jedna z właściwości: {names}",
+	ErrUniqueItems{}:            "musi być unikalny, taki sam jak element {index}",
+	ErrMinContains{}:            "musi zawierać co najmniej {value, plural, one {# pasujący element} few {# pasujące elementy} many {# pasujących elementów} other {# pasującego elementu}}, znaleziono: {count}",
+	ErrMaxContains{}:            "może zawierać co najwyżej {value, plural, one {# pasujący element} few {# pasujące elementy} many {# pasujących elementów} other {# pasującego elementu}}, znaleziono: {count}",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "wartość logiczna",
+	termKey{"integer"}:          "liczba całkowita",
+	termKey{"unsigned integer"}: "liczba naturalna",
+	termKey{"number"}:           "liczba",
+	termKey{"string"}:           "ciąg znaków",
+	termKey{"array"}:            "tablica",
+	termKey{"object"}:           "obiekt",
+	termKey{"unknown type"}:     "nieznany typ",
+	termKey{"true"}:             "prawda",
+	termKey{"false"}:            "fałsz",
+}
+
+// Ukrainian translation of all error messages.
+var Ukrainian = Locale{
+	ErrNoInput{}:                "вхідні дані порожні",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "на позиції {index}: {error}",
+	ErrType{}:                   "неправильний тип: отримано {got}, очікувалося {expected}",
+	ErrDecimal{}:                "має бути десятковим числом",
+	ErrBigInt{}:                 "має бути цілим числом",
+	ErrRequired{}:               "{name} є обов'язковим, але не знайдено",
+	ErrUnexpected{}:             "неочікувана властивість: {name}",
+	ErrUnexpectedItem{}:         "неочікуваний елемент",
+	ErrMultipleOf{}:             "має бути кратним {value}",
+	ErrConst{}:                  "значення має дорівнювати {expected}",
+	ErrEnum{}:                   "значення має бути одним із: {expected}",
+	ErrNot{}:                    "не повинно відповідати схемі",
+	ErrAnyOf{}:                  "має відповідати одній з умов: {errors}",
+	ErrMin{}:                    "має бути більшим або рівним {value}",
+	ErrExclMin{}:                "має бути більшим за {value}",
+	ErrMax{}:                    "має бути меншим або рівним {value}",
+	ErrExclMax{}:                "має бути меншим за {value}",
+	ErrMinLen{}:                 "має містити щонайменше {value, plural, one {# символ} few {# символи} many {# символів} other {# символу}}",
+	ErrMaxLen{}:                 "має містити не більше {value, plural, one {# символу} few {# символів} many {# символів} other {# символу}}",
+	ErrMinGraphemes{}:           "має містити щонайменше {value, plural, one {# символ} few {# символи} many {# символів} other {# символу}}",
+	ErrMaxGraphemes{}:           "має містити не більше {value, plural, one {# символу} few {# символів} many {# символів} other {# символу}}",
+	ErrMaxBytes{}:               "має займати не більше {value, plural, one {# байта} few {# байтів} many {# байтів} other {# байта}}",
+	ErrPrecision{}:              "має містити не більше {value, plural, one {# цифри} few {# цифр} many {# цифр} other {# цифри}}",
+	ErrScale{}:                  "має містити не більше {value, plural, one {# цифри} few {# цифр} many {# цифр} other {# цифри}} після коми",
+	ErrPattern{}:                "має відповідати шаблону",
+	ErrContains{}:               "жоден елемент не підходить: {error}",
+	ErrMinItems{}:               "має містити щонайменше {value, plural, one {# елемент} few {# елементи} many {# елементів} other {# елемента}}",
+	ErrMaxItems{}:               "має містити не більше {value, plural, one {# елемента} few {# елементів} many {# елементів} other {# елемента}}",
+	ErrPropertyNames{}:          "назва властивості {name} {error}",
+	ErrMinProperties{}:          "має містити щонайменше {value, plural, one {# властивість} few {# властивості} many {# властивостей} other {# властивості}}",
+	ErrMaxProperties{}:          "має містити не більше {value, plural, one {# властивості} few {# властивостей} many {# властивостей} other {# властивості}}",
+	ErrCheck{}:                  "не вдалося перевірити значення",
+	ErrNotFound{}:               "{value} не знайдено",
+	ErrExists{}:                 "{value} вже існує",
+	ErrReadOnly{}:               "не повинно бути присутнім у запитах",
+	ErrWriteOnly{}:              "не повинно бути присутнім у відповідях",
+	ErrDeprecated{}:             "застаріло",
+	ErrLessField{}:              "має бути меншим за {name}",
+	ErrLessOrEqualField{}:       "має бути меншим або дорівнювати {name}",
+	ErrEqualField{}:             "має збігатися з {name}",
+	ErrAtLeastOneOf{}:           "потрібна хоча б одна з властивостей: {names}",
+	ErrExactlyOneOf{}:           "потрібна рівно одна з властивостей: {names}",
+	ErrUniqueItems{}:            "має бути унікальним, збігається з елементом {index}",
+	ErrMinContains{}:            "має містити щонайменше {value, plural, one {# відповідний елемент} few {# відповідні елементи} many {# відповідних елементів} other {# відповідного елемента}}, знайдено: {count}",
+	ErrMaxContains{}:            "має містити не більше {value, plural, one {# відповідного елемента} few {# відповідних елементів} many {# відповідних елементів} other {# відповідного елемента}}, знайдено: {count}",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "логічне значення",
+	termKey{"integer"}:          "ціле число",
+	termKey{"unsigned integer"}: "натуральне число",
+	termKey{"number"}:           "число",
+	termKey{"string"}:           "рядок",
+	termKey{"array"}:            "масив",
+	termKey{"object"}:           "об'єкт",
+	termKey{"unknown type"}:     "невідомий тип",
+	termKey{"true"}:             "істина",
+	termKey{"false"}:            "хибність",
+}
+
+// Japanese translation of all error messages.
+var Japanese = Locale{
+	ErrNoInput{}:                "入力が空です",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "{index} 番目: {error}",
+	ErrType{}:                   "無効な型です: {expected} が必要ですが、{got} が指定されました",
+	ErrDecimal{}:                "10進数である必要があります",
+	ErrBigInt{}:                 "整数である必要があります",
+	ErrRequired{}:               "{name} は必須ですが、見つかりません",
+	ErrUnexpected{}:             "予期しないプロパティです: {name}",
+	ErrUnexpectedItem{}:         "予期しない要素です",
+	ErrMultipleOf{}:             "{value} の倍数である必要があります",
+	ErrConst{}:                  "値は {expected} と等しい必要があります",
+	ErrEnum{}:                   "値は次のいずれかである必要があります: {expected}",
+	ErrNot{}:                    "スキーマに一致してはいけません",
+	ErrAnyOf{}:                  "いずれかの条件を満たす必要があります: {errors}",
+	ErrMin{}:                    "{value} 以上である必要があります",
+	ErrExclMin{}:                "{value} より大きい必要があります",
+	ErrMax{}:                    "{value} 以下である必要があります",
+	ErrExclMax{}:                "{value} より小さい必要があります",
+	ErrMinLen{}:                 "{value} 文字以上である必要があります",
+	ErrMaxLen{}:                 "{value} 文字以下である必要があります",
+	ErrMinGraphemes{}:           "{value} 文字以上である必要があります",
+	ErrMaxGraphemes{}:           "{value} 文字以下である必要があります",
+	ErrMaxBytes{}:               "{value} バイト以下である必要があります",
+	ErrPrecision{}:              "{value} 桁以下である必要があります",
+	ErrScale{}:                  "小数点以下は {value} 桁以下である必要があります",
+	ErrPattern{}:                "パターンに一致する必要があります",
+	ErrContains{}:               "一致する項目がありません: {error}",
+	ErrMinItems{}:               "{value} 個以上の項目を含む必要があります",
+	ErrMaxItems{}:               "{value} 個以下の項目を含む必要があります",
+	ErrPropertyNames{}:          "プロパティ名 {name}: {error}",
+	ErrMinProperties{}:          "{value} 個以上のプロパティを含む必要があります",
+	ErrMaxProperties{}:          "{value} 個以下のプロパティを含む必要があります",
+	ErrCheck{}:                  "値を検証できません",
+	ErrNotFound{}:               "{value} が見つかりません",
+	ErrExists{}:                 "{value} は既に存在します",
+	ErrReadOnly{}:               "リクエストに含めることはできません",
+	ErrWriteOnly{}:              "レスポンスに含めることはできません",
+	ErrDeprecated{}:             "非推奨です",
+	ErrLessField{}:              "{name} より小さい必要があります",
+	ErrLessOrEqualField{}:       "{name} 以下である必要があります",
+	ErrEqualField{}:             "{name} と一致する必要があります",
+	ErrAtLeastOneOf{}:           "次のプロパティのいずれかが必要です: {names}",
+	ErrExactlyOneOf{}:           "次のプロパティのうち1つだけが必要です: {names}",
+	ErrUniqueItems{}:            "一意である必要があります ({index} の項目と同じです)",
+	ErrMinContains{}:            "一致する項目を {value} 個以上含む必要があります (見つかった数: {count})",
+	ErrMaxContains{}:            "一致する項目を {value} 個以下含む必要があります (見つかった数: {count})",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "真偽値",
+	termKey{"integer"}:          "整数",
+	termKey{"unsigned integer"}: "符号なし整数",
+	termKey{"number"}:           "数値",
+	termKey{"string"}:           "文字列",
+	termKey{"array"}:            "配列",
+	termKey{"object"}:           "オブジェクト",
+	termKey{"unknown type"}:     "不明な型",
+	termKey{"true"}:             "真",
+	termKey{"false"}:            "偽",
+}
+
+// Chinese (Simplified) translation of all error messages.
+var Chinese = Locale{
+	ErrNoInput{}:                "输入为空",
+	ErrProperty{}:               "{name}: {error}",
+	ErrIndex{}:                  "位置 {index}: {error}",
+	ErrType{}:                   "类型无效: 得到 {got}, 期望 {expected}",
+	ErrDecimal{}:                "必须是十进制数",
+	ErrBigInt{}:                 "必须是整数",
+	ErrRequired{}:               "{name} 为必填项, 但未找到",
+	ErrUnexpected{}:             "意外的属性: {name}",
+	ErrUnexpectedItem{}:         "意外的元素",
+	ErrMultipleOf{}:             "必须是 {value} 的倍数",
+	ErrConst{}:                  "值必须等于 {expected}",
+	ErrEnum{}:                   "值必须是以下之一: {expected}",
+	ErrNot{}:                    "不得匹配该架构",
+	ErrAnyOf{}:                  "必须满足以下条件之一: {errors}",
+	ErrMin{}:                    "必须大于或等于 {value}",
+	ErrExclMin{}:                "必须大于 {value}",
+	ErrMax{}:                    "必须小于或等于 {value}",
+	ErrExclMax{}:                "必须小于 {value}",
+	ErrMinLen{}:                 "长度必须至少为 {value} 个字符",
+	ErrMaxLen{}:                 "长度不得超过 {value} 个字符",
+	ErrMinGraphemes{}:           "长度必须至少为 {value} 个字符",
+	ErrMaxGraphemes{}:           "长度不得超过 {value} 个字符",
+	ErrMaxBytes{}:               "长度不得超过 {value} 个字节",
+	ErrPrecision{}:              "不得超过 {value} 位数字",
+	ErrScale{}:                  "小数点后不得超过 {value} 位数字",
+	ErrPattern{}:                "必须匹配该模式",
+	ErrContains{}:               "没有匹配的元素：{error}",
+	ErrMinItems{}:               "必须至少包含 {value} 个元素",
+	ErrMaxItems{}:               "最多只能包含 {value} 个元素",
+	ErrPropertyNames{}:          "属性名 {name}: {error}",
+	ErrMinProperties{}:          "必须至少包含 {value} 个属性",
+	ErrMaxProperties{}:          "最多只能包含 {value} 个属性",
+	ErrCheck{}:                  "无法校验该值",
+	ErrNotFound{}:               "未找到 {value}",
+	ErrExists{}:                 "{value} 已存在",
+	ErrReadOnly{}:               "不得出现在请求中",
+	ErrWriteOnly{}:              "不得出现在响应中",
+	ErrDeprecated{}:             "已弃用",
+	ErrLessField{}:              "必须小于 {name}",
+	ErrLessOrEqualField{}:       "必须小于或等于 {name}",
+	ErrEqualField{}:             "必须与 {name} 相同",
+	ErrAtLeastOneOf{}:           "至少需要以下属性之一：{names}",
+	ErrExactlyOneOf{}:           "必须恰好有以下属性之一：{names}",
+	ErrUniqueItems{}:            "必须唯一，与位置 {index} 的项目相同",
+	ErrMinContains{}:            "必须至少包含 {value} 个匹配的元素，实际为 {count} 个",
+	ErrMaxContains{}:            "最多只能包含 {value} 个匹配的元素，实际为 {count} 个",
+	termKey{"null"}:             "null",
+	termKey{"boolean"}:          "布尔值",
+	termKey{"integer"}:          "整数",
+	termKey{"unsigned integer"}: "无符号整数",
+	termKey{"number"}:           "数字",
+	termKey{"string"}:           "字符串",
+	termKey{"array"}:            "数组",
+	termKey{"object"}:           "对象",
+	termKey{"unknown type"}:     "未知类型",
+	termKey{"true"}:             "真",
+	termKey{"false"}:            "假",
 }
@@ -1,40 +1,220 @@
 package valdo
 
-import "github.com/orsinium-labs/jsony"
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/orsinium-labs/jsony"
+)
 
 // Locales maps language code to [Locale].
 //
 // It can [Wrap] a [Validator] to translate error messages to the selected language.
 type Locales map[string]Locale
 
+// Locale maps an error type (a zero value of the error) to the error message format.
+//
+// The format can contain placeholders for the error parameters, like "{value}".
+// Messages that depend on a number can use plural forms selected
+// according to the CLDR plural rules of the locale language:
+//
+//	"must contain at least {value, plural, one {# item} other {# items}}"
+//
+// The branches are named after CLDR plural categories ("one", "few", "many", "other")
+// or exact values ("=0"). Inside of a branch, "#" is replaced by the parameter value. Similarly, "select" picks a branch by the parameter value:
+//
+//	"{expected, select, null {must be null} other {must be {expected}}}"
+//
+// Parameter values that are words, like JSON type names and booleans,
+// are translated as [Term]. Floating point numbers are formatted
+// using the decimal separator of the locale language.
 type Locale map[Error]string
 
+// Wrap the validator to translate error messages to the given language.
+//
+// The language is selected using the same rules as [Locales.Negotiate].
+// If there is no matching locale, the validator is returned as is.
 func (ls Locales) Wrap(lang string, v Validator) Validator {
-	locale, hasLang := ls[lang]
-	if !hasLang {
-		if len(lang) != 5 {
-			return v
+	locale, key := ls.Negotiate("", lang)
+	if locale == nil {
+		return v
+	}
+	return locVal{v: v, loc: locale, lang: key}
+}
+
+// Negotiate selects the locale that best matches the given language preferences.
+//
+// Each preference is either a BCP 47 language tag (like "pt-BR" or "zh-Hant-TW")
+// or a raw Accept-Language header value (like "fr-CH, fr;q=0.9, en;q=0.8").
+// Preferences are sorted by their quality value, tags with q=0 are ignored.
+// Tags are compared case-insensitively, and "_" is treated the same as "-".
+//
+// For each tag, the exact match is tried first, and then the tag is truncated
+// from the end ("zh-Hant-TW", "zh-Hant", "zh"). If none of the tags match,
+// a locale for the same base language ("pt" for "pt-BR") is selected.
+// If there is still no match, the locale for the fallback language is used.
+//
+// Returns the selected locale and its language code as it is stored in [Locales].
+// If nothing matches, including the fallback, returns nil and an empty string.
+func (ls Locales) Negotiate(fallback string, prefs ...string) (Locale, string) {
+	keys := make(map[string]string, len(ls))
+	for key := range ls {
+		norm := normalizeTag(key)
+		old, found := keys[norm]
+		if !found || key < old {
+			keys[norm] = key
+		}
+	}
+	tags := parseLangPrefs(prefs)
+
+	// exact match or match after truncating subtags
+	for _, tag := range tags {
+		key, found := lookupTag(keys, tag)
+		if found {
+			return ls[key], key
+		}
+	}
+
+	// match by the base language
+	if len(tags) > 0 {
+		sorted := make([]string, 0, len(keys))
+		for norm := range keys {
+			sorted = append(sorted, norm)
+		}
+		slices.Sort(sorted)
+		for _, tag := range tags {
+			base, _, _ := strings.Cut(tag, "-")
+			for _, norm := range sorted {
+				other, _, _ := strings.Cut(norm, "-")
+				if other == base {
+					key := keys[norm]
+					return ls[key], key
+				}
+			}
+		}
+	}
+
+	key, found := lookupTag(keys, normalizeTag(fallback))
+	if found {
+		return ls[key], key
+	}
+	return nil, ""
+}
+
+// lookupTag finds the key for the given tag, truncating subtags from the end.
+//
+// Implements the "Lookup" scheme from RFC 4647.
+func lookupTag(keys map[string]string, tag string) (string, bool) {
+	for tag != "" {
+		key, found := keys[tag]
+		if found {
+			return key, true
 		}
-		locale, hasLang = ls[lang[:2]]
-		if !hasLang {
-			return v
+		idx := strings.LastIndexByte(tag, '-')
+		if idx < 0 {
+			break
+		}
+		tag = tag[:idx]
+		// a single-character subtag (like "x" in "de-x-foo") cannot stand alone
+		if len(tag) >= 2 && tag[len(tag)-2] == '-' {
+			tag = tag[:len(tag)-2]
 		}
 	}
-	return locale.Wrap(v)
+	return "", false
 }
 
+// parseLangPrefs parses language tags and Accept-Language headers
+// into a list of normalized tags sorted by their quality value.
+func parseLangPrefs(prefs []string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	parsed := make([]weighted, 0, len(prefs))
+	for _, pref := range prefs {
+		for _, part := range strings.Split(pref, ",") {
+			tag, params, _ := strings.Cut(part, ";")
+			tag = normalizeTag(tag)
+			if tag == "" || tag == "*" {
+				continue
+			}
+			q := 1.0
+			for _, param := range strings.Split(params, ";") {
+				name, val, _ := strings.Cut(param, "=")
+				if strings.TrimSpace(name) != "q" {
+					continue
+				}
+				var err error
+				q, err = strconv.ParseFloat(strings.TrimSpace(val), 64)
+				if err != nil {
+					q = 0
+				}
+			}
+			if q <= 0 {
+				continue
+			}
+			parsed = append(parsed, weighted{tag: tag, q: q})
+		}
+	}
+	slices.SortStableFunc(parsed, func(a, b weighted) int {
+		return cmp.Compare(b.q, a.q)
+	})
+	tags := make([]string, len(parsed))
+	for i, p := range parsed {
+		tags[i] = p.tag
+	}
+	return tags
+}
+
+// normalizeTag converts the language tag into lowercase with "-" as the separator.
+func normalizeTag(tag string) string {
+	tag = strings.TrimSpace(tag)
+	tag = strings.ReplaceAll(tag, "_", "-")
+	return strings.ToLower(tag)
+}
+
+// Wrap the validator to translate error messages using the locale.
+//
+// Messages can use plural forms (see [Locale]) but since the locale doesn't know
+// its language, English plural rules are used. To use the plural rules
+// of the locale language, put the locale into [Locales] and use [Locales.Wrap].
 func (loc Locale) Wrap(v Validator) Validator {
 	return locVal{v: v, loc: loc}
 }
 
+// Missing returns all built-in errors that don't have a translation in the locale.
+//
+// Use it in tests to make sure that a custom locale is complete.
+// Terms are checked by [Locale.MissingTerms].
+func (loc Locale) Missing() []Error {
+	res := make([]Error, 0)
+	for _, known := range knownErrors {
+		_, found := loc[known]
+		if !found {
+			res = append(res, known)
+		}
+	}
+	return res
+}
+
 type locVal struct {
-	v   Validator
-	loc Locale
+	v    Validator
+	loc  Locale
+	lang string
 }
 
 // Valdiate implements [Validator].
 func (lv locVal) Validate(data any) Error {
-	err := lv.v.Validate(data)
+	return lv.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (lv locVal) ValidateContext(ctx context.Context, data any) Error {
+	ctx = warnWrap(ctx, lv.translate)
+	err := validateContext(ctx, lv.v, data)
 	if err != nil {
 		return lv.translate(err)
 	}
@@ -43,10 +223,14 @@ func (lv locVal) Validate(data any) Error {
 
 // translate the given error message.
 func (lv locVal) translate(err Error) Error {
-
 	switch e := err.(type) {
 	case Errors:
-		err = e.Map(lv.translate)
+		// Errors cannot be used as a map key because it contains a slice.
+		return e.Map(lv.translate)
+	case ErrMessage:
+		// The custom message cannot be used as a map key because it contains a map.
+		e.Lang = lv.lang
+		return e.Map(lv.translate)
 	case ErrorWrapper:
 		err = e.Map(lv.translate)
 	}
@@ -55,6 +239,14 @@ func (lv locVal) translate(err Error) Error {
 	if !found {
 		return err
 	}
+	get := func(name string) string {
+		return err.SetFormat("{" + name + "}").Error()
+	}
+	format = resolveMessage(format, getPluralRule(lv.lang), get)
+	pe, ok := err.(interface{ params() []pair })
+	if ok {
+		format = lv.localizeParams(format, pe.params())
+	}
 	return err.SetFormat(format)
 }
 
@@ -62,3 +254,8 @@ func (lv locVal) translate(err Error) Error {
 func (lv locVal) Schema() jsony.Object {
 	return lv.v.Schema()
 }
+
+// unwrap implements [wrapper].
+func (lv locVal) unwrap() Validator {
+	return lv.v
+}
@@ -1,6 +1,14 @@
 package valdo_test
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/orsinium-labs/valdo/valdo"
@@ -56,3 +64,264 @@ func TestTranslate_Recursive(t *testing.T) {
 	exp := "в поле items: at 1: значение должно иметь тип integer"
 	isEq(valdo.Validate(val, []byte(`{"items": [1, "hi", 3]}`)).Error(), exp)
 }
+
+func TestLocales_Negotiate(t *testing.T) {
+	t.Parallel()
+	locales := valdo.Locales{
+		"en":      valdo.Locale{},
+		"nl":      valdo.Locale{},
+		"pt-BR":   valdo.Locale{},
+		"zh-Hant": valdo.Locale{},
+		"ru_RU":   valdo.Locale{},
+	}
+	check := func(exp string, fallback string, prefs ...string) {
+		_, got := locales.Negotiate(fallback, prefs...)
+		isEq(got, exp)
+	}
+
+	// exact match
+	check("nl", "", "nl")
+	check("pt-BR", "", "pt-BR")
+	// case-insensitive and underscores
+	check("pt-BR", "", "pt_br")
+	check("pt-BR", "", "PT-BR")
+	check("ru_RU", "", "ru-RU")
+	// truncation
+	check("zh-Hant", "", "zh-Hant-TW")
+	check("nl", "", "nl-BE")
+	check("en", "", "en-x-custom")
+	// base language
+	check("pt-BR", "", "pt")
+	check("pt-BR", "", "pt-PT")
+	check("ru_RU", "", "ru-UA")
+	// quality values
+	check("nl", "", "fr-CH, fr;q=0.9, nl;q=0.8, en;q=0.7")
+	check("en", "", "nl;q=0.5, en")
+	check("en", "", "nl;q=0, en;q=0.1")
+	check("nl", "", "de", "nl;q=0.2")
+	check("pt-BR", "", "pt-PT;q=0.9, fr;q=0.8")
+	// exact matches win over base language matches
+	check("en", "", "pt-PT, en;q=0.5")
+	// fallback
+	check("en", "en", "fr-CH, fr;q=0.9")
+	check("en", "en", "*")
+	check("nl", "nl-BE", "")
+	check("en", "en")
+	check("", "", "fr")
+	check("", "de", "fr")
+}
+
+func TestLocales_Wrap(t *testing.T) {
+	t.Parallel()
+	origV := valdo.Int()
+	for _, lang := range []string{"nl", "nl-BE", "NL_be", "sv, nl;q=0.5"} {
+		val := valdo.DefaultLocales.Wrap(lang, origV)
+		isEq(valdo.Validate(val, []byte(`"hi"`)).Error(), "ongeldig type: kreeg tekenreeks, verwachtte geheel getal")
+	}
+	val := valdo.DefaultLocales.Wrap("sv", origV)
+	isEq(valdo.Validate(val, []byte(`"hi"`)).Error(), "invalid type: got string, expected integer")
+}
+
+func TestTranslate_Plural(t *testing.T) {
+	t.Parallel()
+	check := func(v valdo.Validator, input string, exp string) {
+		isEq(valdo.Validate(v, []byte(input)).Error(), exp)
+	}
+
+	// default English messages
+	{
+		val := valdo.Array(valdo.Any(), valdo.MinItems(1))
+		check(val, `[]`, "must contain at least 1 item")
+		val = valdo.Array(valdo.Any(), valdo.MinItems(2))
+		check(val, `[]`, "must contain at least 2 items")
+	}
+
+	// Russian plural rules
+	{
+		cases := map[uint]string{
+			1:  "должно содержать как минимум 1 элемент",
+			2:  "должно содержать как минимум 2 элемента",
+			5:  "должно содержать как минимум 5 элементов",
+			11: "должно содержать как минимум 11 элементов",
+			21: "должно содержать как минимум 21 элемент",
+			22: "должно содержать как минимум 22 элемента",
+			14: "должно содержать как минимум 14 элементов",
+		}
+		for n, exp := range cases {
+			val := valdo.DefaultLocales.Wrap("ru-RU", valdo.Array(valdo.Any(), valdo.MinItems(n)))
+			check(val, `[]`, exp)
+		}
+	}
+
+	// French plural rules
+	{
+		val := valdo.DefaultLocales.Wrap("fr", valdo.String(valdo.MaxLen(0)))
+		check(val, `"hi"`, "doit contenir au maximum 0 caractère")
+		val = valdo.DefaultLocales.Wrap("fr", valdo.String(valdo.MaxLen(1)))
+		check(val, `"hi"`, "doit contenir au maximum 1 caractère")
+	}
+
+	// exact matches, select, and fractions
+	{
+		locales := valdo.Locales{
+			"ru": valdo.Locale{
+				valdo.ErrMin{}:  "{value, plural, =0 {не может быть отрицательным} other {не меньше {value}, а не # {value, plural, one {x} few {y} many {z} other {w}}}}",
+				valdo.ErrType{}: "{expected, select, integer {нужно целое число} other {нужен тип {expected}}}",
+			},
+		}
+		val := locales.Wrap("ru", valdo.Int(valdo.Min(0)))
+		check(val, `-1`, "не может быть отрицательным")
+		check(val, `"hi"`, "нужно целое число")
+		val = locales.Wrap("ru", valdo.Int(valdo.Min(3)))
+		check(val, `-1`, "не меньше 3, а не 3 y")
+		val = locales.Wrap("ru", valdo.Float64(valdo.Min(1.5)))
+		check(val, `-1`, "не меньше 1,5, а не 1,5 w")
+		check(val, `"hi"`, "нужен тип number")
+	}
+}
+
+func TestTranslate_Errors(t *testing.T) {
+	t.Parallel()
+	origV := valdo.Object(
+		valdo.P("name", valdo.String()),
+		valdo.P("age", valdo.Int()),
+	)
+	val := valdo.DefaultLocales.Wrap("nl", origV)
+	isEq(valdo.Validate(val, []byte(`{}`)).Error(), "name is vereist maar niet gevonden; age is vereist maar niet gevonden")
+}
+
+func TestLocale_Missing(t *testing.T) {
+	t.Parallel()
+	loc := valdo.Locale{
+		valdo.ErrType{}: "ongeldig type",
+	}
+	missing := loc.Missing()
+	isEq(slices.Contains(missing, valdo.Error(valdo.ErrType{})), false)
+	isEq(slices.Contains(missing, valdo.Error(valdo.ErrMin{})), true)
+	isEq(slices.Contains(missing, valdo.Error(valdo.ErrEnum{})), true)
+	// English translates all errors and terms, but only errors are reported by Missing.
+	isEq(len(valdo.Locale{}.Missing()), len(valdo.English)-len(valdo.Locale{}.MissingTerms()))
+	isEq(len(valdo.English.Missing()), 0)
+
+	loc.SetTerm("integer", "geheel getal")
+	terms := loc.MissingTerms()
+	isEq(slices.Contains(terms, "integer"), false)
+	isEq(slices.Contains(terms, "string"), true)
+	isEq(len(valdo.English.MissingTerms()), 0)
+}
+
+// Make sure that every error type defined in the package is known to [Locale.Missing].
+func TestLocale_Missing_AllErrors(t *testing.T) {
+	t.Parallel()
+	paths, err := filepath.Glob("*.go")
+	noErr(err)
+	defined := make([]string, 0)
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		noErr(err)
+		for _, decl := range file.Decls {
+			code, ok := getErrorCode(decl)
+			if ok && code != "errors" && code != "message" {
+				defined = append(defined, code)
+			}
+		}
+	}
+	known := make([]string, 0)
+	for _, e := range (valdo.Locale{}).Missing() {
+		known = append(known, e.(valdo.Coder).Code())
+		_ = e.(valdo.Parametrized).Params()
+	}
+	slices.Sort(defined)
+	slices.Sort(known)
+	isEq(len(defined) > 20, true)
+	isEq(strings.Join(known, " "), strings.Join(defined, " "))
+}
+
+// getErrorCode extracts the error code from the Code method declaration.
+func getErrorCode(decl ast.Decl) (string, bool) {
+	fn, ok := decl.(*ast.FuncDecl)
+	if !ok || fn.Recv == nil || fn.Name.Name != "Code" {
+		return "", false
+	}
+	if len(fn.Body.List) != 1 {
+		return "", false
+	}
+	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", false
+	}
+	lit, ok := ret.Results[0].(*ast.BasicLit)
+	if !ok {
+		return "", false
+	}
+	code, err := strconv.Unquote(lit.Value)
+	return code, err == nil
+}
+
+func TestDefaultLocales_Complete(t *testing.T) {
+	t.Parallel()
+	for lang, loc := range valdo.DefaultLocales {
+		for _, e := range loc.Missing() {
+			panic(fmt.Sprintf("%s: missing translation for %v", lang, e))
+		}
+		for _, term := range loc.MissingTerms() {
+			panic(fmt.Sprintf("%s: missing translation for term %q", lang, term))
+		}
+	}
+}
+
+func TestTranslate_Enum(t *testing.T) {
+	t.Parallel()
+	val := valdo.DefaultLocales.Wrap("de", valdo.Enum("red", "green"))
+	isEq(valdo.Validate(val, []byte(`"blue"`)).Error(), "erwartet, dass der Wert einer der folgenden ist: red, green")
+}
+
+func TestTranslate_PluralRules(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		lang string
+		min  uint
+		exp  string
+	}{
+		{"pl", 1, "musi zawierać co najmniej 1 element"},
+		{"pl", 3, "musi zawierać co najmniej 3 elementy"},
+		{"pl", 12, "musi zawierać co najmniej 12 elementów"},
+		{"pl", 22, "musi zawierać co najmniej 22 elementy"},
+		{"pl", 21, "musi zawierać co najmniej 21 elementów"},
+		{"uk", 21, "має містити щонайменше 21 елемент"},
+		{"uk", 3, "має містити щонайменше 3 елементи"},
+		{"pt", 1, "deve conter pelo menos 1 item"},
+		{"pt", 2, "deve conter pelo menos 2 itens"},
+		{"es", 1, "debe contener al menos 1 elemento"},
+		{"es", 7, "debe contener al menos 7 elementos"},
+		{"it", 2, "deve contenere almeno 2 elementi"},
+		{"ja", 2, "2 個以上の項目を含む必要があります"},
+	}
+	for _, c := range cases {
+		val := valdo.DefaultLocales.Wrap(c.lang, valdo.Array(valdo.Any(), valdo.MinItems(c.min)))
+		isEq(valdo.Validate(val, []byte(`[]`)).Error(), c.exp)
+	}
+}
+
+func TestTranslate_Terms(t *testing.T) {
+	t.Parallel()
+	check := func(v valdo.Validator, input string, exp string) {
+		isEq(valdo.Validate(v, []byte(input)).Error(), exp)
+	}
+	check(valdo.DefaultLocales.Wrap("de", valdo.String()), `1`, "Ungültiger Typ: erhalten Zahl, erwartet Zeichenkette")
+	check(valdo.DefaultLocales.Wrap("nl", valdo.BoolConst(true)), `false`, "verwachtte dat de waarde gelijk zou zijn aan waar")
+	check(valdo.DefaultLocales.Wrap("de", valdo.Float64(valdo.Min(1.5))), `1`, "Muss größer oder gleich 1,5 sein")
+	check(valdo.DefaultLocales.Wrap("ja", valdo.Float64(valdo.Min(1.5))), `1`, "1.5 以上である必要があります")
+
+	// strings that look like terms are not translated
+	check(valdo.DefaultLocales.Wrap("nl", valdo.Const("true")), `"false"`, `verwachtte dat de waarde gelijk zou zijn aan "true"`)
+
+	// missing terms are preserved
+	loc := valdo.Locale{valdo.ErrType{}: "{got} != {expected}"}
+	loc.SetTerm("integer", "heltal")
+	check(loc.Wrap(valdo.Int()), `"hi"`, "string != heltal")
+	check(loc.Wrap(valdo.Int()), `{}`, "object != heltal")
+}
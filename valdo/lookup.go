@@ -0,0 +1,102 @@
+package valdo
+
+import (
+	"context"
+	"sync"
+)
+
+// Exists requires the value to exist, for example, as a product ID in the database.
+//
+// The lookup function reports if the value exists. It receives the context
+// passed into [ValidateContext]. Use [MemSet.Contains] in tests.
+func Exists[T any](lookup func(context.Context, T) (bool, error)) Constraint[T] {
+	c := func(ctx context.Context, v T) Error {
+		found, err := runLookup(ctx, lookup, v)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return ErrNotFound{Value: v}
+		}
+		return nil
+	}
+	return Constraint[T]{checkCtx: c}
+}
+
+// NotExists requires the value to not exist yet, for example, a username to not be taken.
+//
+// The lookup function reports if the value exists. It receives the context
+// passed into [ValidateContext]. Use [MemSet.Contains] in tests.
+func NotExists[T any](lookup func(context.Context, T) (bool, error)) Constraint[T] {
+	c := func(ctx context.Context, v T) Error {
+		found, err := runLookup(ctx, lookup, v)
+		if err != nil {
+			return err
+		}
+		if found {
+			return ErrExists{Value: v}
+		}
+		return nil
+	}
+	return Constraint[T]{checkCtx: c}
+}
+
+// runLookup calls the lookup function, converting failures into [ErrCheck].
+func runLookup[T any](ctx context.Context, lookup func(context.Context, T) (bool, error), v T) (bool, Error) {
+	err := ctx.Err()
+	if err != nil {
+		return false, ErrCheck{Err: err}
+	}
+	found, err := lookup(ctx, v)
+	if err != nil {
+		return false, ErrCheck{Err: err}
+	}
+	return found, nil
+}
+
+// MemSet is an in-memory set of values for [Exists] and [NotExists].
+//
+// Use it in tests as a stand-in for a database. It's safe for concurrent use.
+type MemSet[T comparable] struct {
+	mu    sync.RWMutex
+	items map[T]struct{}
+}
+
+// NewMemSet creates a [MemSet] with the given items.
+func NewMemSet[T comparable](items ...T) *MemSet[T] {
+	s := &MemSet[T]{items: make(map[T]struct{}, len(items))}
+	s.Add(items...)
+	return s
+}
+
+// Add the given items to the set.
+func (s *MemSet[T]) Add(items ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+}
+
+// Remove the given items from the set.
+func (s *MemSet[T]) Remove(items ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, item := range items {
+		delete(s.items, item)
+	}
+}
+
+// Contains reports if the item is in the set.
+//
+// Fails if the context is canceled.
+func (s *MemSet[T]) Contains(ctx context.Context, item T) (bool, error) {
+	err := ctx.Err()
+	if err != nil {
+		return false, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, found := s.items[item]
+	return found, nil
+}
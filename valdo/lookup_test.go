@@ -0,0 +1,142 @@
+package valdo_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/orsinium-labs/jsony"
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestExists(t *testing.T) {
+	t.Parallel()
+	products := valdo.NewMemSet(1, 2, 3)
+	val := valdo.Array(valdo.Int(valdo.Exists(products.Contains)))
+	ctx := context.Background()
+	noErr(valdo.ValidateContext(ctx, val, []byte(`[1, 3]`)))
+	err := valdo.ValidateContext(ctx, val, []byte(`[1, 4]`))
+	isEq(err.Error(), "at 1: 4 is not found")
+
+	products.Add(4)
+	noErr(valdo.ValidateContext(ctx, val, []byte(`[1, 4]`)))
+	products.Remove(1)
+	isEq(valdo.Validate(val, []byte(`[1, 4]`)).Error(), "at 0: 1 is not found")
+}
+
+func TestNotExists(t *testing.T) {
+	t.Parallel()
+	users := valdo.NewMemSet("aragorn")
+	val := valdo.Object(
+		valdo.P("username", valdo.String(valdo.NotExists(users.Contains))),
+	)
+	ctx := context.Background()
+	noErr(valdo.ValidateContext(ctx, val, []byte(`{"username": "legolas"}`)))
+	err := valdo.ValidateContext(ctx, val, []byte(`{"username": "aragorn"}`))
+	isEq(err.Error(), "username: aragorn already exists")
+
+	translated := valdo.DefaultLocales.Wrap("nl", val)
+	err = valdo.ValidateContext(ctx, translated, []byte(`{"username": "aragorn"}`))
+	isEq(err.Error(), "username: aragorn bestaat al")
+}
+
+func TestExists_Canceled(t *testing.T) {
+	t.Parallel()
+	users := valdo.NewMemSet("aragorn")
+	val := valdo.Object(
+		valdo.P("username", valdo.String(valdo.NotExists(users.Contains))),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := valdo.ValidateContext(ctx, val, []byte(`{"username": "legolas"}`))
+	isEq(errors.Is(err, context.Canceled), true)
+	var checkErr valdo.ErrCheck
+	isEq(errors.As(err, &checkErr), true)
+	isEq(err.Error(), "username: cannot check the value")
+	// the cause is not exposed to clients
+	isEq(string(jsony.EncodeBytes(valdo.ErrorJSON(checkErr))), `{"code":"check","message":"cannot check the value"}`)
+}
+
+func TestObject_Concurrent(t *testing.T) {
+	t.Parallel()
+	// Each check waits for as many checks to start as can run at the same time,
+	// so the validation finishes only if the checks run concurrently.
+	want := int32(min(3, runtime.GOMAXPROCS(0)))
+	var started atomic.Int32
+	check := func(ctx context.Context, v int) (bool, error) {
+		started.Add(1)
+		deadline := time.Now().Add(5 * time.Second)
+		for started.Load() < want {
+			if time.Now().After(deadline) {
+				return false, errors.New("checks don't run concurrently")
+			}
+			time.Sleep(time.Millisecond)
+		}
+		return v > 0, nil
+	}
+	positive := valdo.Int(valdo.CheckContext(check, valdo.ErrMin{Value: 1}, nil))
+	val := valdo.Object(
+		valdo.P("a", positive),
+		valdo.P("b", positive),
+		valdo.P("c", positive),
+	).Concurrent()
+	err := valdo.ValidateContext(context.Background(), val, []byte(`{"a": 0, "b": 1, "c": -1}`))
+	isEq(err.Error(), "a: must be greater than or equal to 1; c: must be greater than or equal to 1")
+}
+
+func TestObject_Concurrent_Limit(t *testing.T) {
+	t.Parallel()
+	var running, maxRunning atomic.Int32
+	check := func(ctx context.Context, v int) (bool, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return true, nil
+	}
+	slow := valdo.Int(valdo.CheckContext(check, valdo.ErrMin{Value: 1}, nil))
+	limit := runtime.GOMAXPROCS(0)
+	ps := make([]valdo.PropertyType, limit*3)
+	input := map[string]int{}
+	for i := range ps {
+		name := strconv.Itoa(i)
+		ps[i] = valdo.P(name, slow)
+		input[name] = i
+	}
+	raw, _ := json.Marshal(input)
+	noErr(valdo.Validate(valdo.Object(ps...).Concurrent(), raw))
+	isEq(int(maxRunning.Load()) <= limit, true)
+}
+
+func TestContextValidator(t *testing.T) {
+	t.Parallel()
+	validators := []valdo.Validator{
+		valdo.Bool(), valdo.Int(), valdo.Float64(), valdo.String(), valdo.Null(), valdo.Any(),
+		valdo.Array(valdo.Any()), valdo.Object(), valdo.Tuple(), valdo.Map(valdo.Any()),
+		valdo.AllOf(), valdo.AnyOf(), valdo.Not(valdo.Any()), valdo.Nullable(valdo.Int()),
+		valdo.StringConst("a"), valdo.BoolConst(true), valdo.IntConst(1), valdo.Enum("a"),
+		valdo.Meta{Validator: valdo.Any()}, valdo.WithMessage(valdo.Any(), "oh no"),
+		valdo.English.Wrap(valdo.Any()), valdo.DefaultLocales.Wrap("nl", valdo.Any()),
+		valdo.Func(nil, valdo.ErrNot{}, nil), valdo.FuncContext(nil, valdo.ErrNot{}, nil),
+		valdo.UnevaluatedProperties(valdo.Any(), nil), valdo.UnevaluatedItems(valdo.Any(), nil),
+		valdo.WithDefaults(valdo.Any()), valdo.Sanitize(valdo.Any()),
+		valdo.Decimal(), valdo.BigInt(), valdo.Float64Const(1), valdo.Enum(1),
+		valdo.JSONConst(jsony.Null), valdo.JSONEnum(jsony.Null), valdo.EnumOf(valdo.EnumValue{Value: "a"}),
+	}
+	for _, v := range validators {
+		_, ok := v.(valdo.ContextValidator)
+		if !ok {
+			t.Fatalf("%T doesn't implement ContextValidator", v)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package valdo
+
+import (
+	"context"
+
+	"github.com/orsinium-labs/jsony"
+)
+
+// Messages maps language codes to custom error messages.
+//
+// The message for the empty language code is the default one,
+// used when the error isn't translated or there is no message for the language.
+// Messages can include the original error message using the "{error}" placeholder.
+type Messages map[string]string
+
+// get the message for the given language.
+//
+// Languages are matched using the same rules as [Locales.Negotiate].
+func (ms Messages) get(lang string) string {
+	if lang != "" {
+		keys := make(map[string]string, len(ms))
+		for key := range ms {
+			keys[normalizeTag(key)] = key
+		}
+		key, found := lookupTag(keys, normalizeTag(lang))
+		if found {
+			return ms[key]
+		}
+	}
+	return ms[""]
+}
+
+type withMessage struct {
+	v    Validator
+	msgs Messages
+}
+
+// WithMessage replaces all errors of the validator with the given message.
+//
+// The message is used as is, even if the validator is wrapped by a [Locale].
+// To provide translations, use [WithMessages].
+func WithMessage(v Validator, msg string) Validator {
+	return withMessage{v: v, msgs: Messages{"": msg}}
+}
+
+// WithMessages replaces all errors of the validator with a message
+// in the language selected by [Locales.Wrap].
+func WithMessages(v Validator, msgs Messages) Validator {
+	return withMessage{v: v, msgs: msgs}
+}
+
+// Validate implements [Validator].
+func (w withMessage) Validate(data any) Error {
+	return w.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (w withMessage) ValidateContext(ctx context.Context, data any) Error {
+	err := validateContext(ctx, w.v, data)
+	if err == nil {
+		return nil
+	}
+	return ErrMessage{Messages: w.msgs, Err: err}
+}
+
+// Schema implements [Validator].
+//
+// The default message is added as "errorMessage" keyword.
+func (w withMessage) Schema() jsony.Object {
+	orig := w.v.Schema()
+	res := make(jsony.Object, 0, len(orig)+1)
+	for _, f := range orig {
+		// the message overrides messages of constraints
+		if f.K != "errorMessage" {
+			res = append(res, f)
+		}
+	}
+	msg := w.msgs[""]
+	if msg != "" {
+		res = append(res, jsony.Field{K: "errorMessage", V: jsony.String(msg)})
+	}
+	return res
+}
+
+// unwrap implements [wrapper].
+func (w withMessage) unwrap() Validator {
+	return w.v
+}
+
+// Message replaces the error of the constraint with the given message.
+//
+//	valdo.Pattern(`[0-9]`).Message("must contain a digit")
+func (c Constraint[T]) Message(msg string) Constraint[T] {
+	return c.Messages(Messages{"": msg})
+}
+
+// Messages replaces the error of the constraint with a message
+// in the language selected by [Locales.Wrap].
+func (c Constraint[T]) Messages(msgs Messages) Constraint[T] {
+	orig := c
+	c.check = nil
+	c.checkCtx = func(ctx context.Context, v T) Error {
+		err := orig.validate(ctx, v)
+		if err == nil {
+			return nil
+		}
+		return ErrMessage{Messages: msgs, Err: err}
+	}
+	c.msgs = msgs
+	return c
+}
+
+// appendConstraints adds the schema of each constraint to the given schema.
+//
+// Keywords that are already in the schema with the same value (like "contains"
+// added by both [Contains] and [MinContains]) are added only once.
+// If a constraint has a keyword that is already in the schema with a different value
+// (for example, two constraints both using "anyOf"), all keywords
+// of the constraint are moved together into "allOf".
+//
+// Default custom messages of all constraints are merged into a single
+// "errorMessage" keyword, mapping the (first) constraint keyword to the message.
+func appendConstraints[T any](s jsony.Object, cs []Constraint[T]) jsony.Object {
+	msgs := jsony.Object{}
+	allOf := jsony.Array[jsony.Object]{}
+	for _, c := range cs {
+		conflict := false
+		for _, f := range c.fields {
+			same, found := findKey(s, f)
+			if found && !same {
+				conflict = true
+			}
+		}
+		if conflict {
+			allOf = append(allOf, c.fields)
+		} else {
+			for _, f := range c.fields {
+				_, found := findKey(s, f)
+				if !found {
+					s = append(s, f)
+				}
+			}
+		}
+		msg := c.msgs[""]
+		if msg != "" && len(c.fields) > 0 {
+			msgs = append(msgs, jsony.Field{K: c.fields[0].K, V: jsony.String(msg)})
+		}
+	}
+	if len(allOf) > 0 {
+		s = append(s, jsony.Field{K: "allOf", V: allOf})
+	}
+	if len(msgs) > 0 {
+		s = append(s, jsony.Field{K: "errorMessage", V: msgs})
+	}
+	return s
+}
+
+// findKey checks if the schema already has the keyword of the given field.
+//
+// The first returned value is true if the keyword has the same value.
+func findKey(s jsony.Object, f jsony.Field) (bool, bool) {
+	for _, other := range s {
+		if other.K == f.K {
+			same := string(jsony.EncodeBytes(other.V)) == string(jsony.EncodeBytes(f.V))
+			return same, true
+		}
+	}
+	return false, false
+}
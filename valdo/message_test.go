@@ -0,0 +1,70 @@
+package valdo_test
+
+import (
+	"testing"
+
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestConstraint_Message(t *testing.T) {
+	t.Parallel()
+	val := valdo.String(
+		valdo.MinLen(8),
+		valdo.Pattern(`[0-9]`).Message("must contain a digit"),
+	)
+	noErr(valdo.Validate(val, []byte(`"hunter123"`)))
+	err := valdo.Validate(val, []byte(`"password"`))
+	isErr[valdo.ErrMessage](err)
+	isEq(err.Error(), "must contain a digit")
+	isEq(valdo.Validate(val, []byte(`"pass"`)).Error(), "must be at least 8 characters long; must contain a digit")
+
+	// the message survives translation
+	translated := valdo.DefaultLocales.Wrap("nl", val)
+	isEq(valdo.Validate(translated, []byte(`"pass"`)).Error(), "moet minstens 8 tekens lang zijn; must contain a digit")
+
+	isEq(
+		string(valdo.Schema(val)),
+		`{"type":"string","minLength":8,"pattern":"[0-9]","errorMessage":{"pattern":"must contain a digit"}}`,
+	)
+}
+
+func TestConstraint_Messages(t *testing.T) {
+	t.Parallel()
+	val := valdo.String(valdo.MinLen(8).Messages(valdo.Messages{
+		"":   "too short ({error})",
+		"nl": "te kort ({error})",
+	}))
+	input := []byte(`"pass"`)
+	isEq(valdo.Validate(val, input).Error(), "too short (must be at least 8 characters long)")
+	isEq(valdo.Validate(valdo.DefaultLocales.Wrap("nl-BE", val), input).Error(), "te kort (moet minstens 8 tekens lang zijn)")
+	isEq(valdo.Validate(valdo.DefaultLocales.Wrap("de", val), input).Error(), "too short (Muss mindestens 8 Zeichen lang sein)")
+}
+
+func TestWithMessage(t *testing.T) {
+	t.Parallel()
+	val := valdo.Object(
+		valdo.P("password", valdo.WithMessage(
+			valdo.String(valdo.MinLen(8), valdo.Pattern(`[0-9]`).Message("no digits")),
+			"must contain a digit and be long enough",
+		)),
+	)
+	noErr(valdo.Validate(val, []byte(`{"password": "hunter123"}`)))
+	err := valdo.Validate(val, []byte(`{"password": "pass"}`))
+	isEq(err.Error(), "password: must contain a digit and be long enough")
+	err = valdo.Validate(valdo.DefaultLocales.Wrap("nl", val), []byte(`{"password": "pass"}`))
+	isEq(err.Error(), "password: must contain a digit and be long enough")
+
+	isEq(
+		string(valdo.Schema(val)),
+		`{"type":"object","properties":{"password":{"type":"string","minLength":8,"pattern":"[0-9]","errorMessage":"must contain a digit and be long enough"}},"required":["password"],"additionalProperties":false}`,
+	)
+}
+
+func TestWithMessages(t *testing.T) {
+	t.Parallel()
+	val := valdo.WithMessages(valdo.Int(), valdo.Messages{"nl": "geen getal"})
+	isEq(valdo.Validate(val, []byte(`"hi"`)).Error(), "invalid type: got string, expected integer")
+	translated := valdo.DefaultLocales.Wrap("nl", val)
+	isEq(valdo.Validate(translated, []byte(`"hi"`)).Error(), "geen getal")
+	isEq(string(valdo.Schema(val)), `{"type":"integer"}`)
+}
@@ -1,6 +1,11 @@
 package valdo
 
-import "github.com/orsinium-labs/jsony"
+import (
+	"context"
+	"slices"
+
+	"github.com/orsinium-labs/jsony"
+)
 
 type Meta struct {
 	Validator   Validator
@@ -8,14 +13,33 @@ type Meta struct {
 	Title       string
 	Description string
 	Deprecated  bool
-	Example     jsony.Encoder
-	Examples    []jsony.Encoder
-	Default     jsony.Encoder
+	// ReadOnly values are rejected in [ModeRequest].
+	ReadOnly bool
+	// WriteOnly values are rejected in [ModeResponse].
+	WriteOnly bool
+	Example   jsony.Encoder
+	Examples  []jsony.Encoder
+	Default   jsony.Encoder
 }
 
 // Validate implements [Validator].
 func (m Meta) Validate(data any) Error {
-	return m.Validator.Validate(data)
+	return m.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (m Meta) ValidateContext(ctx context.Context, data any) Error {
+	mode := getMode(ctx)
+	if m.ReadOnly && mode == ModeRequest {
+		return ErrReadOnly{}
+	}
+	if m.WriteOnly && mode == ModeResponse {
+		return ErrWriteOnly{}
+	}
+	if m.Deprecated {
+		addWarning(ctx, ErrDeprecated{})
+	}
+	return validateContext(ctx, m.Validator, data)
 }
 
 // Schema implements [Validator].
@@ -33,6 +57,12 @@ func (m Meta) Schema() jsony.Object {
 	if m.Deprecated {
 		s = append(s, jsony.Field{K: "deprecated", V: jsony.True})
 	}
+	if m.ReadOnly {
+		s = append(s, jsony.Field{K: "readOnly", V: jsony.True})
+	}
+	if m.WriteOnly {
+		s = append(s, jsony.Field{K: "writeOnly", V: jsony.True})
+	}
 
 	var examples jsony.MixedArray
 	if m.Example != nil {
@@ -41,8 +71,72 @@ func (m Meta) Schema() jsony.Object {
 	if m.Examples != nil {
 		examples = append(examples, m.Examples...)
 	}
+	if m.Default != nil {
+		s = append(s, jsony.Field{K: "default", V: m.Default})
+	}
 	if len(examples) > 0 {
 		s = append(s, jsony.Field{K: "examples", V: examples})
 	}
 	return s
 }
+
+// unwrap implements [wrapper].
+func (m Meta) unwrap() Validator {
+	return m.Validator
+}
+
+// Mode is the direction of the API in which the data is validated.
+//
+// It's used to reject read-only and write-only values, see [Meta].
+type Mode uint8
+
+const (
+	// ModeAny allows both read-only and write-only values. It's the default.
+	ModeAny Mode = iota
+	// ModeRequest rejects read-only values with [ErrReadOnly].
+	//
+	// Required properties that are read-only are not required.
+	ModeRequest
+	// ModeResponse rejects write-only values with [ErrWriteOnly].
+	//
+	// Required properties that are write-only are not required.
+	ModeResponse
+)
+
+type modeKey struct{}
+
+// WithMode returns a context for [ValidateContext] that validates the data in the given mode.
+//
+//	ctx := valdo.WithMode(r.Context(), valdo.ModeRequest)
+//	err := valdo.ValidateContext(ctx, user, body)
+func WithMode(ctx context.Context, mode Mode) context.Context {
+	return context.WithValue(ctx, modeKey{}, mode)
+}
+
+// getMode returns the validation mode from the context.
+func getMode(ctx context.Context) Mode {
+	mode, _ := ctx.Value(modeKey{}).(Mode)
+	return mode
+}
+
+// isHidden reports if the validator rejects all values in the given mode.
+//
+// Wrappers, like [WithMessage], are looked through. [AnyOf] (and so [Nullable])
+// and [AllOf] are hidden if any of their validators is.
+func isHidden(v Validator, mode Mode) bool {
+	switch w := v.(type) {
+	case Meta:
+		if w.ReadOnly && mode == ModeRequest || w.WriteOnly && mode == ModeResponse {
+			return true
+		}
+		return isHidden(w.Validator, mode)
+	case anyOf:
+		return slices.ContainsFunc(w.vs, func(v Validator) bool { return isHidden(v, mode) })
+	case allOf:
+		return slices.ContainsFunc(w.vs, func(v Validator) bool { return isHidden(v, mode) })
+	case wrapper:
+		return isHidden(w.unwrap(), mode)
+	default:
+		return false
+	}
+}
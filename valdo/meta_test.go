@@ -0,0 +1,80 @@
+package valdo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/orsinium-labs/jsony"
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestMeta_ReadOnly_WriteOnly(t *testing.T) {
+	t.Parallel()
+	val := valdo.Object(
+		valdo.P("id", valdo.Meta{Validator: valdo.Int(), ReadOnly: true}),
+		valdo.P("name", valdo.String()),
+		valdo.P("password", valdo.Meta{Validator: valdo.String(), WriteOnly: true}),
+	)
+	ctx := context.Background()
+	reqCtx := valdo.WithMode(ctx, valdo.ModeRequest)
+	respCtx := valdo.WithMode(ctx, valdo.ModeResponse)
+
+	full := []byte(`{"id": 1, "name": "aragorn", "password": "elessar"}`)
+	noErr(valdo.Validate(val, full))
+	noErr(valdo.ValidateContext(ctx, val, full))
+	isErr[valdo.ErrRequired](valdo.Validate(val, []byte(`{"name": "aragorn", "password": "elessar"}`)))
+
+	noErr(valdo.ValidateContext(reqCtx, val, []byte(`{"name": "aragorn", "password": "elessar"}`)))
+	err := valdo.ValidateContext(reqCtx, val, full)
+	isErr[valdo.ErrProperty](err)
+	isEq(err.Error(), "id: must not be present in requests")
+	isErr[valdo.ErrRequired](valdo.ValidateContext(reqCtx, val, []byte(`{"name": "aragorn"}`)))
+
+	noErr(valdo.ValidateContext(respCtx, val, []byte(`{"id": 1, "name": "aragorn"}`)))
+	err = valdo.ValidateContext(respCtx, val, full)
+	isErr[valdo.ErrProperty](err)
+	isEq(err.Error(), "password: must not be present in responses")
+	isErr[valdo.ErrRequired](valdo.ValidateContext(respCtx, val, []byte(`{"id": 1}`)))
+
+	translated := valdo.DefaultLocales.Wrap("nl", val)
+	err = valdo.ValidateContext(reqCtx, translated, full)
+	isEq(err.Error(), "id: mag niet aanwezig zijn in verzoeken")
+}
+
+func TestMeta_Validate(t *testing.T) {
+	t.Parallel()
+	val := valdo.Meta{Validator: valdo.Int(valdo.Min(1)), ReadOnly: true, Deprecated: true}
+	noErr(val.Validate(1.))
+	isErr[valdo.ErrMin](val.Validate(0.))
+	isErr[valdo.ErrType](val.Validate("1"))
+	isEq(val.Validate(1.), val.ValidateContext(context.Background(), 1.))
+}
+
+func TestMeta_ReadOnly_Wrapped(t *testing.T) {
+	t.Parallel()
+	id := valdo.Meta{Validator: valdo.Int(), ReadOnly: true}
+	val := valdo.Object(valdo.P("id", valdo.WithMessage(id, "bad id")))
+	reqCtx := valdo.WithMode(context.Background(), valdo.ModeRequest)
+	noErr(valdo.ValidateContext(reqCtx, val, []byte(`{}`)))
+	isErr[valdo.ErrRequired](valdo.Validate(val, []byte(`{}`)))
+
+	val = valdo.Object(
+		valdo.P("id", valdo.Nullable(id)),
+		valdo.P("tags", valdo.AllOf(valdo.Array(valdo.String()), valdo.Meta{Validator: valdo.Any(), WriteOnly: true})),
+	)
+	noErr(valdo.ValidateContext(reqCtx, val, []byte(`{"tags": []}`)))
+	isErr[valdo.ErrRequired](valdo.ValidateContext(reqCtx, val, []byte(`{}`)))
+	respCtx := valdo.WithMode(context.Background(), valdo.ModeResponse)
+	noErr(valdo.ValidateContext(respCtx, val, []byte(`{"id": null}`)))
+	isErr[valdo.ErrRequired](valdo.ValidateContext(respCtx, val, []byte(`{}`)))
+}
+
+func TestMeta_Schema(t *testing.T) {
+	t.Parallel()
+	val := valdo.Meta{Validator: valdo.Int(), Title: "ID", ReadOnly: true}
+	isEq(string(valdo.Schema(val)), `{"type":"integer","title":"ID","readOnly":true}`)
+	val = valdo.Meta{Validator: valdo.String(), WriteOnly: true, Deprecated: true}
+	isEq(string(valdo.Schema(val)), `{"type":"string","deprecated":true,"writeOnly":true}`)
+	val = valdo.Meta{Validator: valdo.Int(), Default: jsony.Int(1), Example: jsony.Int(2)}
+	isEq(string(valdo.Schema(val)), `{"type":"integer","default":1,"examples":[2]}`)
+}
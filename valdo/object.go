@@ -1,7 +1,11 @@
 package valdo
 
 import (
+	"context"
 	"regexp"
+	"runtime"
+	"slices"
+	"sync"
 
 	"github.com/orsinium-labs/jsony"
 )
@@ -12,6 +16,10 @@ type ObjectType struct {
 	cs       []Constraint[map[string]any]
 	extra    bool
 	extraVal Validator
+	// concurrent enables concurrent validation of properties and constraints.
+	concurrent bool
+	// strip unknown properties instead of rejecting them.
+	strip bool
 }
 
 func Map(value Validator, cs ...Constraint[map[string]any]) ObjectType {
@@ -30,6 +38,12 @@ func Object(ps ...PropertyType) ObjectType {
 //   - [PropertyNames]
 //   - [MinProperties]
 //   - [MaxProperties]
+//   - [Less]
+//   - [LessOrEqual]
+//   - [EqualFields]
+//   - [AtLeastOneOf]
+//   - [ExactlyOneOf]
+//   - [NoneOf]
 func (obj ObjectType) Constrain(cs ...Constraint[map[string]any]) ObjectType {
 	obj.cs = append(obj.cs, cs...)
 	return obj
@@ -43,24 +57,222 @@ func (obj ObjectType) Constrain(cs ...Constraint[map[string]any]) ObjectType {
 func (obj ObjectType) AllowExtra(v Validator) ObjectType {
 	obj.extra = true
 	obj.extraVal = v
+	obj.strip = false
 	return obj
 }
 
+// Drop unknown properties instead of rejecting them.
+//
+// The unknown properties are reported as [ErrUnexpected] warnings (see [WithWarnings])
+// and removed from the data returned by [Unmarshal] and [Clean].
+// Nested objects that don't allow additional properties drop them too.
+// The schema still has "additionalProperties" set to false.
+//
+// Useful for forward-compatible clients that may send properties from newer API versions.
+func (obj ObjectType) StripUnknown() ObjectType {
+	obj.extra = false
+	obj.extraVal = nil
+	obj.strip = true
+	return obj
+}
+
+// Validate properties and constraints of the object concurrently.
+//
+// Useful when some of them run slow context-aware checks, like [Exists].
+// The order of the errors is the same as without concurrency.
+// At most [runtime.GOMAXPROCS] checks of the object run at the same time.
+func (obj ObjectType) Concurrent() ObjectType {
+	obj.concurrent = true
+	return obj
+}
+
+// Extend returns a new object with the given properties added.
+//
+// If the object already has a property with the same name, it is replaced.
+// If the same name is passed more than once, the last property wins.
+// Constraints and pattern properties of the object are preserved.
+func (obj ObjectType) Extend(ps ...PropertyType) ObjectType {
+	res := make([]PropertyType, 0, len(obj.ps)+len(ps))
+	res = append(res, obj.ps...)
+	for _, p := range ps {
+		idx := slices.IndexFunc(res, func(q PropertyType) bool { return q.name == p.name })
+		if idx >= 0 {
+			res[idx] = p
+		} else {
+			res = append(res, p)
+		}
+	}
+	obj.ps = res
+	return obj
+}
+
+// Pick returns a new object with only the given properties.
+//
+// Constraints and pattern properties of the object are preserved,
+// except constraints (like [Less]) and [PropertyType.AlsoRequire] names
+// that refer to the dropped properties.
+// A constraint referring to several properties is removed as a whole
+// even if only one of them is dropped, which makes the validation looser:
+// with [AtLeastOneOf]("email", "phone"), omitting "email" doesn't make "phone" required.
+// Panics if the object doesn't have one of the properties.
+func (obj ObjectType) Pick(names ...string) ObjectType {
+	keep := obj.names(names)
+	drop := make(map[string]struct{})
+	for _, p := range obj.ps {
+		_, found := keep[p.name]
+		if !found && p.rex == nil {
+			drop[p.name] = struct{}{}
+		}
+	}
+	return obj.drop(drop)
+}
+
+// Omit returns a new object without the given properties.
+//
+// Constraints and pattern properties of the object are preserved,
+// except constraints (like [Less]) and [PropertyType.AlsoRequire] names
+// that refer to the omitted properties.
+// A constraint referring to several properties is removed as a whole
+// even if only one of them is omitted, which makes the validation looser:
+// with [AtLeastOneOf]("email", "phone"), omitting "email" doesn't make "phone" required.
+// Panics if the object doesn't have one of the properties.
+func (obj ObjectType) Omit(names ...string) ObjectType {
+	return obj.drop(obj.names(names))
+}
+
+// drop removes the given properties from the object,
+// together with constraints and dependent requirements referring to them.
+func (obj ObjectType) drop(names map[string]struct{}) ObjectType {
+	dropped := func(name string) bool {
+		_, found := names[name]
+		return found
+	}
+	ps := make([]PropertyType, 0, len(obj.ps))
+	for _, p := range obj.ps {
+		if p.rex == nil && dropped(p.name) {
+			continue
+		}
+		if len(p.depReq) > 0 {
+			depReq := make([]string, 0, len(p.depReq))
+			for _, name := range p.depReq {
+				if !dropped(name) {
+					depReq = append(depReq, name)
+				}
+			}
+			p.depReq = depReq
+		}
+		ps = append(ps, p)
+	}
+	cs := make([]Constraint[map[string]any], 0, len(obj.cs))
+	for _, c := range obj.cs {
+		if !slices.ContainsFunc(c.props, dropped) {
+			cs = append(cs, c)
+		}
+	}
+	obj.ps = ps
+	obj.cs = cs
+	return obj
+}
+
+// Partial returns a new object with all properties marked as [PropertyType.Optional].
+//
+// Useful for partial updates, like PATCH requests.
+func (obj ObjectType) Partial() ObjectType {
+	res := make([]PropertyType, len(obj.ps))
+	for i, p := range obj.ps {
+		p.optional = true
+		res[i] = p
+	}
+	obj.ps = res
+	return obj
+}
+
+// Required returns a new object with all properties marked as required.
+//
+// It's the inverse of [ObjectType.Partial]. Pattern properties are not affected.
+func (obj ObjectType) Required() ObjectType {
+	res := make([]PropertyType, len(obj.ps))
+	for i, p := range obj.ps {
+		if p.rex == nil {
+			p.optional = false
+		}
+		res[i] = p
+	}
+	obj.ps = res
+	return obj
+}
+
+// isStripping reports if unknown properties of the object must be dropped,
+// either because of [ObjectType.StripUnknown] on it or on one of the parent objects.
+func (obj ObjectType) isStripping(ctx context.Context) bool {
+	if obj.extra {
+		return false
+	}
+	inherited, _ := ctx.Value(stripKey{}).(bool)
+	return obj.strip || inherited
+}
+
+type stripKey struct{}
+
+// isDeclared reports if the property is declared in the object,
+// either by name or by a pattern.
+func (obj ObjectType) isDeclared(name string) bool {
+	for _, p := range obj.ps {
+		if p.rex != nil && p.rex.MatchString(name) || p.rex == nil && p.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// index returns the index of the property with the given name or -1.
+func (obj ObjectType) index(name string) int {
+	for i, p := range obj.ps {
+		if p.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// names converts the property names into a set, checking that they all exist.
+func (obj ObjectType) names(names []string) map[string]struct{} {
+	res := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if obj.index(name) < 0 {
+			panic("unknown property: " + name)
+		}
+		res[name] = struct{}{}
+	}
+	return res
+}
+
 // Validate implements [Validator].
 func (obj ObjectType) Validate(data any) Error {
+	return obj.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (obj ObjectType) ValidateContext(ctx context.Context, data any) Error {
 	switch d := data.(type) {
 	case map[string]any:
-		return obj.validateMap(d)
+		return obj.validateMap(ctx, d)
 	default:
 		return ErrType{Got: getTypeName(data), Expected: "object"}
 	}
 }
 
-func (obj ObjectType) validateMap(data map[string]any) Error {
+func (obj ObjectType) validateMap(ctx context.Context, data map[string]any) Error {
 	if data == nil {
 		return ErrType{Got: "null", Expected: "object"}
 	}
-	res := Errors{}
+	res := newRunner(obj.concurrent)
+	strip := obj.isStripping(ctx)
+	childCtx := childContext(ctx)
+	if strip {
+		childCtx = context.WithValue(childCtx, stripKey{}, true)
+	}
+	mode := getMode(ctx)
 	handledNames := map[string]struct{}{}
 	for _, p := range obj.ps {
 		if p.rex != nil {
@@ -69,20 +281,20 @@ func (obj ObjectType) validateMap(data map[string]any) Error {
 					continue
 				}
 				handledNames[name] = struct{}{}
-				res.Add(p.validate(val))
+				res.Run(func() Error { return p.validate(warnProperty(childCtx, name), val) })
 			}
 			continue
 		}
 
 		val, found := data[p.name]
 		if !found {
-			if !p.optional {
+			if !p.optional && !isHidden(p.validator, mode) {
 				res.Add(ErrRequired{Name: p.name})
 			}
 			continue
 		}
 		handledNames[p.name] = struct{}{}
-		res.Add(p.validate(val))
+		res.Run(func() Error { return p.validate(warnProperty(childCtx, p.name), val) })
 		if len(p.depReq) > 0 {
 			for _, name := range p.depReq {
 				_, found := data[name]
@@ -93,27 +305,45 @@ func (obj ObjectType) validateMap(data map[string]any) Error {
 		}
 	}
 	for _, c := range obj.cs {
-		res.Add(c.check(data))
+		res.Run(func() Error { return c.validate(ctx, data) })
 	}
 	if obj.extraVal != nil {
 		for name, val := range data {
 			_, handled := handledNames[name]
 			if !handled {
-				err := obj.extraVal.Validate(val)
-				if err != nil {
-					res.Add(ErrProperty{Name: name, Err: err})
-				}
+				res.Run(func() Error {
+					err := validateContext(warnProperty(childCtx, name), obj.extraVal, val)
+					if err != nil {
+						return ErrProperty{Name: name, Err: err}
+					}
+					return nil
+				})
 			}
 		}
 	} else if !obj.extra {
 		for name := range data {
 			_, handled := handledNames[name]
-			if !handled {
+			if handled {
+				continue
+			}
+			if strip {
+				addWarning(ctx, ErrUnexpected{Name: name})
+			} else {
 				res.Add(ErrUnexpected{Name: name})
 			}
 		}
 	}
-	return res.Flatten()
+
+	// additionalProperties evaluates all properties
+	ann := getAnnotations(ctx)
+	if obj.extraVal != nil || !obj.extra {
+		ann.addAllProps()
+	} else {
+		for name := range handledNames {
+			ann.addProp(name)
+		}
+	}
+	return res.Wait()
 }
 
 // Schema implements [Validator].
@@ -169,10 +399,7 @@ func (obj ObjectType) Schema() jsony.Object {
 	} else if !obj.extra {
 		res = append(res, jsony.Field{K: "additionalProperties", V: jsony.False})
 	}
-	for _, c := range obj.cs {
-		res = append(res, c.field)
-	}
-	return res
+	return appendConstraints(res, obj.cs)
 }
 
 // PropertyType is constructed by [Property].
@@ -215,10 +442,65 @@ func (p PropertyType) AlsoRequire(name string, names ...string) PropertyType {
 	return p
 }
 
-func (p PropertyType) validate(data any) Error {
-	err := p.validator.Validate(data)
+func (p PropertyType) validate(ctx context.Context, data any) Error {
+	err := validateContext(ctx, p.validator, data)
 	if err != nil {
 		return ErrProperty{Name: p.name, Err: err}
 	}
 	return nil
 }
+
+// runner collects errors of checks that can run concurrently.
+type runner struct {
+	concurrent bool
+	wg         sync.WaitGroup
+	// sem limits the number of checks running at the same time
+	sem chan struct{}
+	// slots for errors in the order the checks were started
+	slots []*Error
+}
+
+func newRunner(concurrent bool) *runner {
+	r := &runner{concurrent: concurrent}
+	if concurrent {
+		r.sem = make(chan struct{}, runtime.GOMAXPROCS(0))
+	}
+	return r
+}
+
+// Add the error (if not nil) to the list of errors.
+func (r *runner) Add(err Error) {
+	if err != nil {
+		r.slots = append(r.slots, &err)
+	}
+}
+
+// Run the check and add its error to the list of errors.
+//
+// If concurrency is enabled, the check is run in a new goroutine.
+// If all slots are busy, it blocks until one of the running checks finishes.
+func (r *runner) Run(check func() Error) {
+	if !r.concurrent {
+		r.Add(check())
+		return
+	}
+	slot := new(Error)
+	r.slots = append(r.slots, slot)
+	r.wg.Add(1)
+	r.sem <- struct{}{}
+	go func() {
+		defer r.wg.Done()
+		defer func() { <-r.sem }()
+		*slot = check()
+	}()
+}
+
+// Wait for all checks to finish and return the errors.
+func (r *runner) Wait() Error {
+	r.wg.Wait()
+	res := Errors{}
+	for _, slot := range r.slots {
+		res.Add(*slot)
+	}
+	return res.Flatten()
+}
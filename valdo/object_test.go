@@ -83,3 +83,120 @@ func TestObject_Schema(t *testing.T) {
 		isEq(res, exp)
 	}
 }
+
+func TestObject_Extend(t *testing.T) {
+	t.Parallel()
+	base := valdo.O(
+		valdo.P("name", valdo.S()),
+		valdo.P("age", valdo.I()).Optional(),
+	)
+	val := base.Extend(
+		valdo.P("id", valdo.I()),
+		valdo.P("age", valdo.I(valdo.Min(0))),
+	)
+	noErr(valdo.Validate(val, []byte(`{"id": 1, "name": "aragorn", "age": 87}`)))
+	isErr[valdo.ErrRequired](valdo.Validate(val, []byte(`{"id": 1, "name": "aragorn"}`)))
+	isErr[valdo.ErrProperty](valdo.Validate(val, []byte(`{"id": 1, "name": "aragorn", "age": -1}`)))
+	exp := `{"type":"object","properties":{"name":{"type":"string"},"age":{"type":"integer","minimum":0},"id":{"type":"integer"}},"required":["name","age","id"],"additionalProperties":false}`
+	isEq(string(valdo.Schema(val)), exp)
+
+	// the original object is not changed
+	noErr(valdo.Validate(base, []byte(`{"name": "aragorn"}`)))
+	isErr[valdo.ErrUnexpected](valdo.Validate(base, []byte(`{"name": "aragorn", "id": 1}`)))
+}
+
+func TestObject_Extend_Duplicates(t *testing.T) {
+	t.Parallel()
+	val := valdo.O().Extend(
+		valdo.P("age", valdo.I()),
+		valdo.P("age", valdo.I(valdo.Min(0))),
+	)
+	isEq(string(valdo.Schema(val)), `{"type":"object","properties":{"age":{"type":"integer","minimum":0}},"required":["age"],"additionalProperties":false}`)
+}
+
+func TestObject_Pick_Omit(t *testing.T) {
+	t.Parallel()
+	base := valdo.O(
+		valdo.P("id", valdo.I()),
+		valdo.P("name", valdo.S()),
+		valdo.P("^x-", valdo.S()),
+	).Constrain(valdo.MaxProperties(3))
+
+	picked := base.Pick("name")
+	noErr(valdo.Validate(picked, []byte(`{"name": "aragorn", "x-race": "human"}`)))
+	isErr[valdo.ErrUnexpected](valdo.Validate(picked, []byte(`{"name": "aragorn", "id": 1}`)))
+	isErr[valdo.ErrMaxProperties](valdo.Validate(picked, []byte(`{"name": "aragorn", "x-a": "", "x-b": "", "x-c": ""}`)))
+
+	omitted := base.Omit("id")
+	noErr(valdo.Validate(omitted, []byte(`{"name": "aragorn", "x-race": "human"}`)))
+	isErr[valdo.ErrUnexpected](valdo.Validate(omitted, []byte(`{"name": "aragorn", "id": 1}`)))
+	isEq(string(valdo.Schema(omitted)), string(valdo.Schema(picked)))
+
+	defer func() {
+		isEq(recover(), any("unknown property: age"))
+	}()
+	base.Omit("age")
+}
+
+func TestObject_Omit_Constraints(t *testing.T) {
+	t.Parallel()
+	base := valdo.O(
+		valdo.P("start", valdo.I()).Optional(),
+		valdo.P("end", valdo.I()).Optional().AlsoRequire("start"),
+		valdo.P("name", valdo.S()).Optional().AlsoRequire("start", "end"),
+	).Constrain(valdo.Less[int]("start", "end"), valdo.MaxProperties(2))
+
+	val := base.Omit("start")
+	noErr(valdo.Validate(val, []byte(`{"end": 1, "name": "x"}`)))
+	err := valdo.Validate(val, []byte(`{"end": 1, "name": "x", "start": 2}`))
+	isEq(err.Error(), "must contain at most 2 properties; unexpected property: start")
+	exp := `{"type":"object","properties":{"end":{"type":"integer"},"name":{"type":"string"}},"dependentRequired":{"name":["end"]},"additionalProperties":false,"maxProperties":2}`
+	isEq(string(valdo.Schema(val)), exp)
+
+	val = base.Pick("end", "name")
+	isEq(string(valdo.Schema(val)), exp)
+}
+
+func TestObject_Omit_MultiFieldConstraints(t *testing.T) {
+	t.Parallel()
+	base := valdo.O(
+		valdo.P("email", valdo.S()).Optional(),
+		valdo.P("phone", valdo.S()).Optional(),
+		valdo.P("name", valdo.S()).Optional(),
+	).Constrain(valdo.AtLeastOneOf("email", "phone"))
+	isErr[valdo.ErrAtLeastOneOf](valdo.Validate(base, []byte(`{"name": "aragorn"}`)))
+
+	// the whole constraint is dropped, so "phone" alone isn't required
+	val := base.Omit("email")
+	noErr(valdo.Validate(val, []byte(`{"name": "aragorn"}`)))
+	exp := `{"type":"object","properties":{"phone":{"type":"string"},"name":{"type":"string"}},"additionalProperties":false}`
+	isEq(string(valdo.Schema(val)), exp)
+
+	val = base.Pick("phone", "name")
+	noErr(valdo.Validate(val, []byte(`{"name": "aragorn"}`)))
+	isEq(string(valdo.Schema(val)), exp)
+
+	// constraints on the remaining properties only are kept
+	val = base.Omit("name")
+	isErr[valdo.ErrAtLeastOneOf](valdo.Validate(val, []byte(`{}`)))
+}
+
+func TestObject_Partial_Required(t *testing.T) {
+	t.Parallel()
+	base := valdo.O(
+		valdo.P("id", valdo.I()),
+		valdo.P("name", valdo.S(valdo.MinLen(2))).Optional(),
+	).Constrain(valdo.MinProperties(1))
+
+	partial := base.Partial()
+	noErr(valdo.Validate(partial, []byte(`{"name": "aragorn"}`)))
+	isErr[valdo.ErrProperty](valdo.Validate(partial, []byte(`{"name": "a"}`)))
+	isErr[valdo.ErrMinProperties](valdo.Validate(partial, []byte(`{}`)))
+	exp := `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string","minLength":2}},"additionalProperties":false,"minProperties":1}`
+	isEq(string(valdo.Schema(partial)), exp)
+
+	required := partial.Required()
+	noErr(valdo.Validate(required, []byte(`{"id": 1, "name": "aragorn"}`)))
+	isErr[valdo.ErrRequired](valdo.Validate(required, []byte(`{"id": 1}`)))
+	isErr[valdo.ErrRequired](valdo.Validate(base.Required(), []byte(`{"id": 1}`)))
+}
@@ -0,0 +1,316 @@
+package valdo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Plural categories defined by CLDR.
+//
+// https://cldr.unicode.org/index/cldr-spec/plural-rules
+const (
+	pluralOne   = "one"
+	pluralFew   = "few"
+	pluralMany  = "many"
+	pluralOther = "other"
+)
+
+// pluralRule selects the CLDR plural category for a number.
+type pluralRule func(o operands) string
+
+// Plural rules for cardinal numbers, by language.
+//
+// https://www.unicode.org/cldr/charts/latest/supplemental/language_plural_rules.html
+var pluralRules = map[string]pluralRule{
+	"en":    pluralRuleOneNoFraction,
+	"de":    pluralRuleOneNoFraction,
+	"nl":    pluralRuleOneNoFraction,
+	"es":    pluralRuleSpanish,
+	"fr":    pluralRuleFrench,
+	"it":    pluralRuleItalian,
+	"pt":    pluralRulePortuguese,
+	"pt-pt": pluralRuleItalian,
+	"pl":    pluralRulePolish,
+	"ru":    pluralRuleEastSlavic,
+	"uk":    pluralRuleEastSlavic,
+	"ja":    pluralRuleNone,
+	"zh":    pluralRuleNone,
+}
+
+// getPluralRule returns the plural rule for the given language tag.
+//
+// If the language is not known, English rules are used.
+func getPluralRule(lang string) pluralRule {
+	lang = normalizeTag(lang)
+	rule, found := pluralRules[lang]
+	if found {
+		return rule
+	}
+	base, _, _ := strings.Cut(lang, "-")
+	rule, found = pluralRules[base]
+	if found {
+		return rule
+	}
+	return pluralRuleOneNoFraction
+}
+
+// English, German, Dutch, and many other languages: "1 item", "2 items", "1.0 items".
+func pluralRuleOneNoFraction(o operands) string {
+	if o.i == 1 && o.v == 0 {
+		return pluralOne
+	}
+	return pluralOther
+}
+
+// Japanese, Chinese: no plural forms.
+func pluralRuleNone(o operands) string {
+	return pluralOther
+}
+
+// French: "0 élément", "1 élément", "2 éléments", "1000000 d'éléments".
+func pluralRuleFrench(o operands) string {
+	if o.i == 0 || o.i == 1 {
+		return pluralOne
+	}
+	if isMillions(o) {
+		return pluralMany
+	}
+	return pluralOther
+}
+
+// Spanish: "1 elemento", "1.0 elemento", "2 elementos", "1000000 de elementos".
+func pluralRuleSpanish(o operands) string {
+	if o.n == 1 {
+		return pluralOne
+	}
+	if isMillions(o) {
+		return pluralMany
+	}
+	return pluralOther
+}
+
+// Italian: "1 elemento", "2 elementi", "1000000 di elementi".
+func pluralRuleItalian(o operands) string {
+	if o.i == 1 && o.v == 0 {
+		return pluralOne
+	}
+	if isMillions(o) {
+		return pluralMany
+	}
+	return pluralOther
+}
+
+// Portuguese: "0 item", "1 item", "1.5 item", "2 itens", "1000000 de itens".
+func pluralRulePortuguese(o operands) string {
+	if o.i == 0 || o.i == 1 {
+		return pluralOne
+	}
+	if isMillions(o) {
+		return pluralMany
+	}
+	return pluralOther
+}
+
+// isMillions is a "many" condition shared by romance languages.
+func isMillions(o operands) bool {
+	return o.v == 0 && o.i != 0 && o.i%1000000 == 0
+}
+
+// Polish: "1 element", "2 elementy", "5 elementów", "1,5 elementu".
+func pluralRulePolish(o operands) string {
+	if o.v != 0 {
+		return pluralOther
+	}
+	if o.i == 1 {
+		return pluralOne
+	}
+	mod10 := o.i % 10
+	mod100 := o.i % 100
+	if mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14) {
+		return pluralFew
+	}
+	return pluralMany
+}
+
+// Russian and Ukrainian: "1 элемент", "2 элемента", "5 элементов", "1,5 элемента".
+func pluralRuleEastSlavic(o operands) string {
+	if o.v != 0 {
+		return pluralOther
+	}
+	mod10 := o.i % 10
+	mod100 := o.i % 100
+	if mod10 == 1 && mod100 != 11 {
+		return pluralOne
+	}
+	if mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14) {
+		return pluralFew
+	}
+	return pluralMany
+}
+
+// operands of a number used by CLDR plural rules.
+//
+// https://unicode.org/reports/tr35/tr35-numbers.html#Operands
+type operands struct {
+	// n is the absolute value of the number.
+	n float64
+	// i is the integer part of the number.
+	//
+	// Only the last 18 digits are preserved, which is enough for plural rules.
+	i uint64
+	// v is the number of visible fraction digits, with trailing zeros.
+	v int
+}
+
+// parseOperands extracts plural operands from a formatted number.
+func parseOperands(s string) (operands, bool) {
+	s = strings.TrimSpace(s)
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return operands{}, false
+	}
+	if strings.ContainsAny(s, "eE") {
+		s = strconv.FormatFloat(n, 'f', -1, 64)
+	}
+	s = strings.TrimLeft(s, "+-")
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if len(intPart) > 18 {
+		intPart = intPart[len(intPart)-18:]
+	}
+	i, _ := strconv.ParseUint(intPart, 10, 64)
+	if n < 0 {
+		n = -n
+	}
+	return operands{n: n, i: i, v: len(fracPart)}, true
+}
+
+// selectPlural returns the plural category for the formatted number.
+func selectPlural(rule pluralRule, value string) string {
+	o, ok := parseOperands(value)
+	if !ok {
+		return pluralOther
+	}
+	return rule(o)
+}
+
+// resolveMessage replaces "plural" and "select" blocks in the message
+// with the branch selected for the current parameter values.
+//
+// The syntax is a subset of ICU MessageFormat:
+//
+//	{value, plural, =0 {no items} one {# item} other {# items}}
+//	{expected, select, null {must be null} other {must be {expected}}}
+//
+// The "#" inside of a plural branch is replaced by the parameter placeholder.
+// Simple placeholders, like "{value}", are preserved as is.
+func resolveMessage(msg string, rule pluralRule, get func(name string) string) string {
+	if !strings.Contains(msg, ",") {
+		return msg
+	}
+	var res strings.Builder
+	for {
+		start := strings.IndexByte(msg, '{')
+		if start < 0 {
+			break
+		}
+		end := matchingBrace(msg, start)
+		if end < 0 {
+			break
+		}
+		res.WriteString(msg[:start])
+		block := msg[start : end+1]
+		resolved, ok := resolveBlock(block[1:len(block)-1], rule, get)
+		if ok {
+			res.WriteString(resolved)
+		} else {
+			res.WriteString(block)
+		}
+		msg = msg[end+1:]
+	}
+	res.WriteString(msg)
+	return res.String()
+}
+
+// resolveBlock resolves the content of a single "plural" or "select" block.
+//
+// Returns false if the block is not a "plural" or "select" block.
+func resolveBlock(block string, rule pluralRule, get func(name string) string) (string, bool) {
+	name, rest, found := strings.Cut(block, ",")
+	if !found {
+		return "", false
+	}
+	kind, rest, found := strings.Cut(rest, ",")
+	if !found {
+		return "", false
+	}
+	name = strings.TrimSpace(name)
+	kind = strings.TrimSpace(kind)
+	if kind != "plural" && kind != "select" {
+		return "", false
+	}
+	branches, ok := parseBranches(rest)
+	if !ok {
+		return "", false
+	}
+
+	value := get(name)
+	selected, found := branches["="+strings.TrimSpace(value)]
+	if !found && kind == "plural" {
+		selected, found = branches[selectPlural(rule, value)]
+	}
+	if !found && kind == "select" {
+		selected, found = branches[value]
+	}
+	if !found {
+		selected, found = branches[pluralOther]
+	}
+	if !found {
+		return "{" + name + "}", true
+	}
+	selected = resolveMessage(selected, rule, get)
+	if kind == "plural" {
+		selected = strings.ReplaceAll(selected, "#", "{"+name+"}")
+	}
+	return selected, true
+}
+
+// parseBranches parses "key1 {message1} key2 {message2}" into a map.
+func parseBranches(s string) (map[string]string, bool) {
+	branches := make(map[string]string)
+	for {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return branches, true
+		}
+		start := strings.IndexByte(s, '{')
+		if start <= 0 {
+			return nil, false
+		}
+		end := matchingBrace(s, start)
+		if end < 0 {
+			return nil, false
+		}
+		key := strings.TrimSpace(s[:start])
+		branches[key] = s[start+1 : end]
+		s = s[end+1:]
+	}
+}
+
+// matchingBrace returns the index of the brace closing the one at the given index.
+//
+// Returns -1 if the brace is not closed.
+func matchingBrace(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
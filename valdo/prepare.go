@@ -0,0 +1,143 @@
+package valdo
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// needsPrepare reports if the data must be prepared by [prepare] before unmarshaling.
+func needsPrepare(v Validator) bool {
+	found := false
+	walk(v, "", func(_ string, v Validator) {
+		switch v := v.(type) {
+		case sanitized, defaultsVal:
+			found = true
+		case ObjectType:
+			found = found || v.strip
+		}
+	})
+	return found
+}
+
+// prepareInput applies transforms and defaults to the valid input JSON.
+func prepareInput(ctx context.Context, v Validator, input []byte) ([]byte, error) {
+	data, err := decodeNumbers(input)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(prepare(ctx, v, data, false))
+}
+
+// prepare applies transforms of [Sanitize] and, inside of [WithDefaults], the defaults.
+//
+// The data must be decoded using [decodeNumbers], so that numbers are kept exactly
+// as they are in the input. Maps and slices of the data are modified in place.
+func prepare(ctx context.Context, v Validator, data any, defaults bool) any {
+	switch v := v.(type) {
+	case sanitized:
+		return prepare(ctx, v.v, v.transform(data), defaults)
+	case defaultsVal:
+		return prepare(ctx, v.v, data, true)
+	case wrapper:
+		return prepare(ctx, v.unwrap(), data, defaults)
+	case allOf:
+		for _, val := range v.vs {
+			data = prepare(ctx, val, data, defaults)
+		}
+	case anyOf:
+		// the warnings have been already reported during the validation
+		vCtx, _ := warnBuffer(ctx)
+		for _, val := range v.vs {
+			if validateContext(vCtx, val, toFloats(data)) == nil {
+				return prepare(ctx, val, data, defaults)
+			}
+		}
+	case ArrayType:
+		items, _ := data.([]any)
+		for i, item := range items {
+			items[i] = prepare(ctx, v.elem, item, defaults)
+		}
+	case TupleType:
+		items, _ := data.([]any)
+		for i, item := range items {
+			val := v.extraVal
+			if i < len(v.vals) {
+				val = v.vals[i]
+			}
+			if val != nil {
+				items[i] = prepare(ctx, val, item, defaults)
+			}
+		}
+	case ObjectType:
+		obj, _ := data.(map[string]any)
+		if obj == nil {
+			return data
+		}
+		if v.isStripping(ctx) {
+			ctx = context.WithValue(ctx, stripKey{}, true)
+			for name := range obj {
+				if !v.isDeclared(name) {
+					delete(obj, name)
+				}
+			}
+		}
+		handled := map[string]struct{}{}
+		for _, p := range v.ps {
+			if p.rex != nil {
+				for name, val := range obj {
+					if p.rex.MatchString(name) {
+						handled[name] = struct{}{}
+						obj[name] = prepare(ctx, p.validator, val, defaults)
+					}
+				}
+				continue
+			}
+			val, found := obj[p.name]
+			if found {
+				handled[p.name] = struct{}{}
+				obj[p.name] = prepare(ctx, p.validator, val, defaults)
+				continue
+			}
+			if defaults && p.optional {
+				def, found := getDefault(p.validator)
+				if found {
+					obj[p.name] = def
+				}
+			}
+		}
+		if v.extraVal != nil {
+			for name, val := range obj {
+				_, found := handled[name]
+				if !found {
+					obj[name] = prepare(ctx, v.extraVal, val, defaults)
+				}
+			}
+		}
+	}
+	return data
+}
+
+// toFloats converts all [json.Number] values into float64,
+// the same way as json.Unmarshal decodes numbers into any.
+func toFloats(data any) any {
+	switch d := data.(type) {
+	case json.Number:
+		f, _ := strconv.ParseFloat(string(d), 64)
+		return f
+	case []any:
+		res := make([]any, len(d))
+		for i, item := range d {
+			res[i] = toFloats(item)
+		}
+		return res
+	case map[string]any:
+		res := make(map[string]any, len(d))
+		for name, val := range d {
+			res[name] = toFloats(val)
+		}
+		return res
+	default:
+		return data
+	}
+}
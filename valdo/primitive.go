@@ -1,6 +1,7 @@
 package valdo
 
 import (
+	"context"
 	"math"
 
 	"github.com/orsinium-labs/jsony"
@@ -22,13 +23,18 @@ func (p PrimitiveType[T]) Constrain(cs ...Constraint[T]) PrimitiveType[T] {
 
 // Validate implements [Validator].
 func (p PrimitiveType[T]) Validate(raw any) Error {
+	return p.ValidateContext(context.Background(), raw)
+}
+
+// ValidateContext implements [ContextValidator].
+func (p PrimitiveType[T]) ValidateContext(ctx context.Context, raw any) Error {
 	val, fErr := p.val(raw)
 	if fErr != nil {
 		return fErr
 	}
 	res := Errors{}
 	for _, c := range p.cs {
-		res.Add(c.check(val))
+		res.Add(c.validate(ctx, val))
 	}
 	return res.Flatten()
 }
@@ -38,10 +44,7 @@ func (p PrimitiveType[T]) Schema() jsony.Object {
 	res := jsony.Object{
 		jsony.Field{K: "type", V: jsony.String(p.name)},
 	}
-	for _, c := range p.cs {
-		res = append(res, c.field)
-	}
-	return res
+	return appendConstraints(res, p.cs)
 }
 
 // Bool maps to "boolean" in JSON and "bool" in Go.
@@ -171,6 +174,11 @@ func (n nullType) Validate(data any) Error {
 	return ErrType{Got: getTypeName(data), Expected: "null"}
 }
 
+// ValidateContext implements [ContextValidator].
+func (n nullType) ValidateContext(ctx context.Context, data any) Error {
+	return n.Validate(data)
+}
+
 // Schema implements [Validator].
 func (n nullType) Schema() jsony.Object {
 	return jsony.Object{
@@ -190,6 +198,11 @@ func (anyType) Validate(data any) Error {
 	return nil
 }
 
+// ValidateContext implements [ContextValidator].
+func (anyType) ValidateContext(ctx context.Context, data any) Error {
+	return nil
+}
+
 // Schema implements [Validator].
 func (anyType) Schema() jsony.Object {
 	return jsony.Object{}
@@ -0,0 +1,166 @@
+package valdo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Term is a word used as a parameter value in error messages,
+// like a JSON type name in [ErrType] or a boolean in [ErrConst].
+//
+// Terms are translated using [Locale.SetTerm]:
+//
+//	loc := valdo.Locale{valdo.ErrType{}: "ongeldig type: {got}"}
+//	loc.SetTerm("integer", "geheel getal")
+type Term string
+
+// termKey is the [Locale] key for the translation of a [Term].
+//
+// It implements [Error] only to be usable as a key, and it's unexported
+// so that terms cannot be used where errors are expected.
+type termKey struct {
+	term Term
+}
+
+// GetDefault implements [Error] interface.
+func (k termKey) GetDefault() Error {
+	return k
+}
+
+// Code implements [Coder] interface.
+func (k termKey) Code() string {
+	return termPrefix + string(k.term)
+}
+
+// SetFormat implements [Error] interface.
+func (k termKey) SetFormat(f string) Error {
+	return k
+}
+
+// Error implements [error] interface.
+func (k termKey) Error() string {
+	return string(k.term)
+}
+
+// SetTerm sets the translation of the term.
+func (loc Locale) SetTerm(t Term, msg string) {
+	loc[termKey{t}] = msg
+}
+
+// Term returns the translation of the term, if there is one.
+func (loc Locale) Term(t Term) (string, bool) {
+	msg, found := loc[termKey{t}]
+	return msg, found
+}
+
+// MissingTerms returns all terms used by built-in errors that don't have a translation in the locale.
+//
+// Use it together with [Locale.Missing] in tests to make sure that a custom locale is complete.
+func (loc Locale) MissingTerms() []Term {
+	res := make([]Term, 0)
+	for _, known := range knownTerms {
+		_, found := loc.Term(known)
+		if !found {
+			res = append(res, known)
+		}
+	}
+	return res
+}
+
+// termPrefix is the prefix of term codes in translation files that distinguishes terms from errors.
+const termPrefix = "term:"
+
+// knownTerms is the list of all terms that built-in errors can use as parameters.
+var knownTerms = []Term{
+	"null",
+	"boolean",
+	"integer",
+	"unsigned integer",
+	"number",
+	"string",
+	"array",
+	"object",
+	"unknown type",
+	"true",
+	"false",
+}
+
+// Decimal separators by language, for languages that don't use a period.
+//
+// https://www.unicode.org/cldr/charts/latest/by_type/numbers.symbols.html
+var decimalSeparators = map[string]string{
+	"de": ",",
+	"es": ",",
+	"fr": ",",
+	"it": ",",
+	"nl": ",",
+	"pl": ",",
+	"pt": ",",
+	"ru": ",",
+	"uk": ",",
+}
+
+// getDecimalSeparator returns the decimal separator for the given language tag.
+func getDecimalSeparator(lang string) string {
+	lang = normalizeTag(lang)
+	sep, found := decimalSeparators[lang]
+	if found {
+		return sep
+	}
+	base, _, _ := strings.Cut(lang, "-")
+	sep, found = decimalSeparators[base]
+	if found {
+		return sep
+	}
+	return "."
+}
+
+// localizeParams substitutes into the message parameters that depend on the language:
+// terms and booleans are translated, and floats, including the ones in lists of values,
+// use the language decimal separator.
+//
+// Other placeholders are preserved as is.
+func (lv locVal) localizeParams(msg string, pairs []pair) string {
+	args := make([]string, 0, len(pairs)*2)
+	for _, p := range pairs {
+		var value string
+		switch v := p.value.(type) {
+		case Term:
+			value = lv.term(v)
+		case bool:
+			value = lv.term(Term(fmt.Sprintf("%v", v)))
+		case float32, float64:
+			value = formatFloat(v, getDecimalSeparator(lv.lang))
+		case json.Number:
+			value = strings.Replace(string(v), ".", getDecimalSeparator(lv.lang), 1)
+		case valueList:
+			value = v.format(getDecimalSeparator(lv.lang))
+		default:
+			continue
+		}
+		args = append(args, "{"+p.name+"}", value)
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return strings.NewReplacer(args...).Replace(msg)
+}
+
+// formatFloat formats the float using the given decimal separator.
+func formatFloat(v any, sep string) string {
+	res := fmt.Sprintf("%v", v)
+	if !strings.ContainsAny(res, "eE") {
+		res = strings.Replace(res, ".", sep, 1)
+	}
+	return res
+}
+
+// term returns the translation of the term or the term itself if it's not translated.
+func (lv locVal) term(t Term) string {
+	msg, found := lv.loc.Term(t)
+	if found {
+		return msg
+	}
+	return string(t)
+}
@@ -0,0 +1,174 @@
+package valdo
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/orsinium-labs/jsony"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Transform modifies the data before validation, see [Sanitize].
+type Transform struct {
+	// apply the transform to the data validated by the validator.
+	apply func(v Validator, data any) any
+}
+
+// TransformString creates a custom [Transform] for string values.
+//
+// Values of other types are left as is.
+func TransformString(f func(string) string) Transform {
+	return Transform{apply: func(_ Validator, data any) any {
+		s, ok := data.(string)
+		if !ok {
+			return data
+		}
+		return f(s)
+	}}
+}
+
+// TrimSpace removes leading and trailing white space from strings.
+func TrimSpace() Transform {
+	return TransformString(strings.TrimSpace)
+}
+
+// ToLower converts strings to lower case.
+func ToLower() Transform {
+	return TransformString(strings.ToLower)
+}
+
+// CaseFold converts strings to a form suitable for case-insensitive comparison.
+//
+// Unlike [ToLower], it maps all case variants of a letter to the same letter,
+// like "ς" and "Σ" to "σ".
+func CaseFold() Transform {
+	return TransformString(func(s string) string {
+		return strings.Map(foldRune, s)
+	})
+}
+
+// NormalizeNFC converts strings to the Unicode Normalization Form C.
+//
+// For example, "e" followed by the combining acute accent becomes "é".
+func NormalizeNFC() Transform {
+	return TransformString(norm.NFC.String)
+}
+
+// CollapseSpaces replaces each sequence of white space characters in strings
+// with a single space.
+func CollapseSpaces() Transform {
+	return TransformString(func(s string) string {
+		return strings.Join(strings.Fields(s), " ")
+	})
+}
+
+// DropUnknown removes properties of an object that are not declared in it.
+//
+// It has no effect if the object allows additional properties
+// or if the validator is not an [Object].
+func DropUnknown() Transform {
+	return Transform{apply: func(v Validator, data any) any {
+		obj, ok := data.(map[string]any)
+		if !ok {
+			return data
+		}
+		o, ok := unwrapObject(v)
+		if !ok || o.extra {
+			return data
+		}
+		res := make(map[string]any, len(obj))
+		for name, val := range obj {
+			if o.isDeclared(name) {
+				res[name] = val
+			}
+		}
+		return res
+	}}
+}
+
+// foldRune returns the smallest rune equivalent to the given one under simple case folding.
+func foldRune(r rune) rune {
+	res := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		res = min(res, f)
+	}
+	return unicode.ToLower(res)
+}
+
+// unwrapObject returns the object wrapped by the validator, if any.
+func unwrapObject(v Validator) (ObjectType, bool) {
+	for {
+		switch w := v.(type) {
+		case ObjectType:
+			return w, true
+		case wrapper:
+			v = w.unwrap()
+		default:
+			return ObjectType{}, false
+		}
+	}
+}
+
+type sanitized struct {
+	v  Validator
+	ts []Transform
+}
+
+// Sanitize applies the transforms to the data before validating it.
+//
+// [Clean] and [Unmarshal] return the transformed data.
+// [Validate] only validates the transformed data and doesn't return it.
+//
+//	email := valdo.Sanitize(valdo.String(valdo.Pattern(`^\S+@\S+$`)), valdo.TrimSpace(), valdo.ToLower())
+func Sanitize(v Validator, ts ...Transform) Validator {
+	return sanitized{v: v, ts: ts}
+}
+
+// Validate implements [Validator].
+func (s sanitized) Validate(data any) Error {
+	return s.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (s sanitized) ValidateContext(ctx context.Context, data any) Error {
+	return validateContext(ctx, s.v, s.transform(data))
+}
+
+// Schema implements [Validator].
+func (s sanitized) Schema() jsony.Object {
+	return s.v.Schema()
+}
+
+// unwrap implements [wrapper].
+func (s sanitized) unwrap() Validator {
+	return s.v
+}
+
+func (s sanitized) transform(data any) any {
+	for _, t := range s.ts {
+		data = t.apply(s.v, data)
+	}
+	return data
+}
+
+// Clean validates the input JSON and returns the cleaned data: transformed by [Sanitize]
+// and without unknown properties dropped by [ObjectType.StripUnknown].
+//
+// The data has the same types as the data passed into [Validator].
+func Clean(v Validator, input []byte) (any, error) {
+	return CleanContext(context.Background(), v, input)
+}
+
+// Like [Clean] but passes the context to context-aware checks.
+func CleanContext(ctx context.Context, v Validator, input []byte) (any, error) {
+	err := ValidateContext(ctx, v, input)
+	if err != nil {
+		return nil, err
+	}
+	data, err := decodeNumbers(input)
+	if err != nil {
+		return nil, err
+	}
+	return toFloats(prepare(ctx, v, data, false)), nil
+}
@@ -0,0 +1,88 @@
+package valdo_test
+
+import (
+	"testing"
+
+	"github.com/orsinium-labs/jsony"
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestSanitize_Validate(t *testing.T) {
+	t.Parallel()
+	email := valdo.Sanitize(
+		valdo.String(valdo.Pattern(`^[a-z]+@[a-z.]+$`)),
+		valdo.TrimSpace(), valdo.ToLower(),
+	)
+	noErr(valdo.Validate(email, []byte(`" Alice@Example.COM "`)))
+	isErr[valdo.ErrPattern](valdo.Validate(email, []byte(`"alice at example.com"`)))
+	isErr[valdo.ErrType](valdo.Validate(email, []byte(`1`)))
+	isEq(string(valdo.Schema(email)), `{"type":"string","pattern":"^[a-z]+@[a-z.]+$"}`)
+}
+
+func TestSanitize_Strings(t *testing.T) {
+	t.Parallel()
+	clean := func(v valdo.Validator, input string) any {
+		res, err := valdo.Clean(v, []byte(input))
+		noErr(err)
+		return res
+	}
+	isEq(clean(valdo.Sanitize(valdo.String(), valdo.TrimSpace()), `"  a b  "`), any("a b"))
+	isEq(clean(valdo.Sanitize(valdo.String(), valdo.CollapseSpaces()), `" a \t\n b  "`), any("a b"))
+	isEq(clean(valdo.Sanitize(valdo.String(), valdo.ToLower()), `"ÀbC"`), any("àbc"))
+	isEq(clean(valdo.Sanitize(valdo.String(), valdo.CaseFold()), `"ΣΑΣ ς K"`), any("σασ σ k"))
+	isEq(clean(valdo.Sanitize(valdo.String(), valdo.NormalizeNFC()), `"e\u0301"`), any("\u00e9"))
+	reverse := valdo.TransformString(func(s string) string {
+		r := []rune(s)
+		for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+			r[i], r[j] = r[j], r[i]
+		}
+		return string(r)
+	})
+	isEq(clean(valdo.Sanitize(valdo.String(), reverse), `"abc"`), any("cba"))
+	isEq(clean(valdo.Sanitize(valdo.Int(), valdo.TrimSpace()), `1`), any(1.))
+}
+
+func TestClean(t *testing.T) {
+	t.Parallel()
+	val := valdo.Sanitize(valdo.Object(
+		valdo.P("name", valdo.Sanitize(valdo.String(valdo.MinLen(1)), valdo.CollapseSpaces())),
+		valdo.P("tags", valdo.Array(valdo.Sanitize(valdo.String(), valdo.TrimSpace(), valdo.ToLower()))),
+		valdo.P("age", valdo.Int()).Optional(),
+	), valdo.DropUnknown())
+
+	res, err := valdo.Clean(val, []byte(`{"name": " Aragorn  Elessar ", "tags": [" King "], "age": 87, "city": "Minas Tirith"}`))
+	noErr(err)
+	obj := res.(map[string]any)
+	isEq(len(obj), 3)
+	isEq(obj["name"], any("Aragorn Elessar"))
+	isEq(obj["tags"].([]any)[0], any("king"))
+	isEq(obj["age"], any(87.))
+
+	_, err = valdo.Clean(val, []byte(`{"name": "   ", "tags": []}`))
+	isErr[valdo.ErrProperty](err)
+
+	// unknown properties are dropped before validation
+	noErr(valdo.Validate(val, []byte(`{"name": "aragorn", "tags": [], "city": "Minas Tirith"}`)))
+	// but only if the object doesn't allow them
+	extra := valdo.Sanitize(valdo.Object().AllowExtra(nil), valdo.DropUnknown())
+	res, err = valdo.Clean(extra, []byte(`{"city": "Minas Tirith"}`))
+	noErr(err)
+	isEq(len(res.(map[string]any)), 1)
+}
+
+func TestUnmarshal_Sanitize(t *testing.T) {
+	t.Parallel()
+	type target struct {
+		Email string `json:"email"`
+		ID    int64  `json:"id"`
+		Admin bool   `json:"admin"`
+	}
+	val := valdo.WithDefaults(valdo.Object(
+		valdo.P("email", valdo.Sanitize(valdo.String(), valdo.TrimSpace(), valdo.ToLower())),
+		valdo.P("id", valdo.Int()),
+		valdo.P("admin", valdo.Meta{Validator: valdo.Bool(), Default: jsony.True}).Optional(),
+	))
+	res, err := valdo.Unmarshal[target](val, []byte(`{"email": " Alice@Example.COM ", "id": 9007199254740993}`))
+	noErr(err)
+	isEq(res, target{Email: "alice@example.com", ID: 9007199254740993, Admin: true})
+}
@@ -0,0 +1,320 @@
+package valdo
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/orsinium-labs/jsony"
+)
+
+// ReadLocaleJSON reads a [Locale] from a JSON object mapping error codes to messages.
+//
+//	{"min_len": "must be at least {value} characters long"}
+//
+// Error codes are the values returned by Code method of the errors.
+// Translations for [Term] use "term:" prefix, like "term:integer".
+func ReadLocaleJSON(r io.Reader) (Locale, error) {
+	var raw map[string]string
+	err := json.NewDecoder(r).Decode(&raw)
+	if err != nil {
+		return nil, fmt.Errorf("decode JSON: %w", err)
+	}
+	loc := make(Locale, len(raw))
+	for code, msg := range raw {
+		err = loc.add(code, msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return loc, nil
+}
+
+// ReadLocaleFlat reads a [Locale] from a flat key-value file.
+//
+// Each line contains an error code and a message separated by "=".
+// Empty lines and lines starting with "#" are ignored. Spaces around
+// the message are trimmed, unless the message is a double-quoted string.
+//
+//	# comment
+//	min_len = must be at least {value} characters long
+//	const = "\"{expected}\" is expected"
+func ReadLocaleFlat(r io.Reader) (Locale, error) {
+	loc := make(Locale)
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		code, msg, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected code and message separated by =", lineNo)
+		}
+		msg = strings.TrimSpace(msg)
+		if strings.HasPrefix(msg, `"`) {
+			var err error
+			msg, err = strconv.Unquote(msg)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: unquote message: %w", lineNo, err)
+			}
+		}
+		err := loc.add(strings.TrimSpace(code), msg)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+	}
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+	return loc, nil
+}
+
+// ReadLocalePO reads a [Locale] from a gettext PO file.
+//
+// The error code is taken from msgctxt or, if there is no context, from msgid.
+// Entries with an empty msgstr and entries marked as fuzzy are skipped.
+//
+//	msgctxt "min_len"
+//	msgid "must be at least {value} characters long"
+//	msgstr "moet minstens {value} tekens lang zijn"
+func ReadLocalePO(r io.Reader) (Locale, error) {
+	loc := make(Locale)
+	var entry poEntry
+	var field *string
+	flush := func() error {
+		defer func() { entry = poEntry{} }()
+		if entry.fuzzy || entry.msgstr == "" || entry.msgid == "" {
+			return nil
+		}
+		code := entry.msgctxt
+		if code == "" {
+			code = entry.msgid
+		}
+		return loc.add(code, entry.msgstr)
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			err := flush()
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			field = nil
+			continue
+		case strings.HasPrefix(line, "#,"):
+			if strings.Contains(line, "fuzzy") {
+				entry.fuzzy = true
+			}
+			continue
+		case line[0] == '#':
+			continue
+		case line[0] == '"':
+			// continuation of the previous string
+		default:
+			keyword, rest, _ := strings.Cut(line, " ")
+			switch keyword {
+			case "msgctxt":
+				field = &entry.msgctxt
+			case "msgid":
+				field = &entry.msgid
+			case "msgstr":
+				field = &entry.msgstr
+			default:
+				return nil, fmt.Errorf("line %d: unsupported keyword %q", lineNo, keyword)
+			}
+			line = strings.TrimSpace(rest)
+		}
+		if field == nil {
+			return nil, fmt.Errorf("line %d: string without a keyword", lineNo)
+		}
+		s, err := strconv.Unquote(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: unquote string: %w", lineNo, err)
+		}
+		*field += s
+	}
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+	err = flush()
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", lineNo, err)
+	}
+	return loc, nil
+}
+
+type poEntry struct {
+	msgctxt string
+	msgid   string
+	msgstr  string
+	fuzzy   bool
+}
+
+// ReadLocales reads all translation files from the root of the given file system.
+//
+// The file name without the extension is used as the language code
+// and the extension defines the file format:
+//
+//   - ".json" is read using [ReadLocaleJSON].
+//   - ".txt" is read using [ReadLocaleFlat].
+//   - ".po" is read using [ReadLocalePO].
+//
+// Files with other extensions are ignored.
+func ReadLocales(fsys fs.FS) (Locales, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read dir: %w", err)
+	}
+	locales := make(Locales)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		ext := path.Ext(name)
+		var read func(io.Reader) (Locale, error)
+		switch ext {
+		case ".json":
+			read = ReadLocaleJSON
+		case ".txt":
+			read = ReadLocaleFlat
+		case ".po":
+			read = ReadLocalePO
+		default:
+			continue
+		}
+		file, err := fsys.Open(name)
+		if err != nil {
+			return nil, fmt.Errorf("open %s: %w", name, err)
+		}
+		loc, err := read(file)
+		_ = file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", name, err)
+		}
+		locales[strings.TrimSuffix(name, ext)] = loc
+	}
+	return locales, nil
+}
+
+// add the message for the error with the given code.
+func (loc Locale) add(code, msg string) error {
+	term, found := strings.CutPrefix(code, termPrefix)
+	if found {
+		loc.SetTerm(Term(term), msg)
+		return nil
+	}
+	for _, known := range knownErrors {
+		if errorCode(known) == code {
+			loc[known] = msg
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown error code %q", code)
+}
+
+// entries returns the locale errors sorted in the order of [knownErrors] and [knownTerms].
+func (loc Locale) entries() []Error {
+	res := make([]Error, 0, len(loc))
+	for _, known := range knownErrors {
+		_, found := loc[known]
+		if found {
+			res = append(res, known)
+		}
+	}
+	for _, known := range knownTerms {
+		_, found := loc.Term(known)
+		if found {
+			res = append(res, termKey{known})
+		}
+	}
+	other := make([]Error, 0)
+	for key := range loc {
+		// Errors without a code cannot be read back, so they are skipped.
+		if errorCode(key) != "" && !slices.Contains(res, key) {
+			other = append(other, key)
+		}
+	}
+	slices.SortFunc(other, func(a, b Error) int {
+		return strings.Compare(errorCode(a), errorCode(b))
+	})
+	return append(res, other...)
+}
+
+// WriteJSON writes the locale in the format supported by [ReadLocaleJSON].
+//
+// Use it on [English] to generate a template for translators.
+func (loc Locale) WriteJSON(w io.Writer) error {
+	obj := make(jsony.UnsafeObject, 0, len(loc))
+	for _, key := range loc.entries() {
+		obj = append(obj, jsony.UnsafeField{
+			K: jsony.String(errorCode(key)),
+			V: jsony.String(loc[key]),
+		})
+	}
+	var buf bytes.Buffer
+	err := json.Indent(&buf, jsony.EncodeBytes(obj), "", "  ")
+	if err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// WriteFlat writes the locale in the format supported by [ReadLocaleFlat].
+//
+// Use it on [English] to generate a template for translators.
+func (loc Locale) WriteFlat(w io.Writer) error {
+	var buf bytes.Buffer
+	for _, key := range loc.entries() {
+		msg := loc[key]
+		if msg != strings.TrimSpace(msg) || strings.HasPrefix(msg, `"`) {
+			msg = strconv.Quote(msg)
+		}
+		fmt.Fprintf(&buf, "%s = %s\n", errorCode(key), msg)
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// WritePO writes the locale in the format supported by [ReadLocalePO].
+//
+// The error code is written as msgctxt, the [English] message as msgid,
+// and the message from the locale as msgstr.
+// Use it on [English] to generate a template for translators.
+func (loc Locale) WritePO(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("msgid \"\"\nmsgstr \"\"\n")
+	buf.WriteString("\"Content-Type: text/plain; charset=UTF-8\\n\"\n")
+	for _, key := range loc.entries() {
+		msg := loc[key]
+		msgid, found := English[key]
+		if !found {
+			msgid = msg
+		}
+		buf.WriteByte('\n')
+		fmt.Fprintf(&buf, "msgctxt %s\n", strconv.Quote(errorCode(key)))
+		fmt.Fprintf(&buf, "msgid %s\n", strconv.Quote(msgid))
+		fmt.Fprintf(&buf, "msgstr %s\n", strconv.Quote(msg))
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
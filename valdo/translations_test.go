@@ -0,0 +1,156 @@
+package valdo_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func isSameLocale(a, b valdo.Locale) {
+	isEq(len(a), len(b))
+	for key, msg := range a {
+		isEq(b[key], msg)
+	}
+}
+
+func TestLocale_RoundTrip(t *testing.T) {
+	t.Parallel()
+	type format struct {
+		write func(valdo.Locale, io.Writer) error
+		read  func(io.Reader) (valdo.Locale, error)
+	}
+	formats := []format{
+		{valdo.Locale.WriteJSON, valdo.ReadLocaleJSON},
+		{valdo.Locale.WriteFlat, valdo.ReadLocaleFlat},
+		{valdo.Locale.WritePO, valdo.ReadLocalePO},
+	}
+	locales := []valdo.Locale{
+		valdo.English,
+		valdo.Russian,
+		{valdo.ErrNot{}: " not ", valdo.ErrConst{}: `"{expected}"`},
+	}
+	for _, f := range formats {
+		for _, loc := range locales {
+			var buf bytes.Buffer
+			noErr(f.write(loc, &buf))
+			got, err := f.read(&buf)
+			noErr(err)
+			isSameLocale(got, loc)
+		}
+	}
+}
+
+func TestReadLocaleJSON(t *testing.T) {
+	t.Parallel()
+	input := `{"min_len": "minimum {value}", "type": "wrong type", "term:integer": "int"}`
+	loc, err := valdo.ReadLocaleJSON(strings.NewReader(input))
+	noErr(err)
+	exp := valdo.Locale{
+		valdo.ErrMinLen{}: "minimum {value}",
+		valdo.ErrType{}:   "wrong type",
+	}
+	exp.SetTerm("integer", "int")
+	isSameLocale(loc, exp)
+	term, _ := loc.Term("integer")
+	isEq(term, "int")
+
+	_, err = valdo.ReadLocaleJSON(strings.NewReader(`{"min_length": "minimum"}`))
+	isEq(err.Error(), `unknown error code "min_length"`)
+	_, err = valdo.ReadLocaleJSON(strings.NewReader(`["min_len"]`))
+	isEq(err != nil, true)
+}
+
+func TestReadLocaleFlat(t *testing.T) {
+	t.Parallel()
+	input := `
+# a comment
+min_len = minimum {value}
+type=wrong = type
+not = " | "
+`
+	loc, err := valdo.ReadLocaleFlat(strings.NewReader(input))
+	noErr(err)
+	isSameLocale(loc, valdo.Locale{
+		valdo.ErrMinLen{}: "minimum {value}",
+		valdo.ErrType{}:   "wrong = type",
+		valdo.ErrNot{}:    " | ",
+	})
+
+	_, err = valdo.ReadLocaleFlat(strings.NewReader("\nmin_len minimum"))
+	isEq(err.Error(), "line 2: expected code and message separated by =")
+	_, err = valdo.ReadLocaleFlat(strings.NewReader("min = min\nmax_length = max"))
+	isEq(err.Error(), `line 2: unknown error code "max_length"`)
+}
+
+func TestReadLocalePO(t *testing.T) {
+	t.Parallel()
+	input := `
+# header
+msgid ""
+msgstr ""
+"Language: nl\n"
+
+#. translator comment
+msgctxt "min_len"
+msgid "must be at least {value} characters long"
+msgstr ""
+"moet minstens {value} "
+"tekens lang zijn"
+
+msgctxt "max_len"
+msgid "must be at most {value} characters long"
+msgstr ""
+
+#, fuzzy
+msgctxt "type"
+msgid "invalid type"
+msgstr "ongeldig type"
+
+msgid "required"
+msgstr "{name} is vereist"
+`
+	loc, err := valdo.ReadLocalePO(strings.NewReader(input))
+	noErr(err)
+	isSameLocale(loc, valdo.Locale{
+		valdo.ErrMinLen{}:   "moet minstens {value} tekens lang zijn",
+		valdo.ErrRequired{}: "{name} is vereist",
+	})
+
+	_, err = valdo.ReadLocalePO(strings.NewReader("msgid \"min\"\nmsgid_plural \"mins\""))
+	isEq(err.Error(), `line 2: unsupported keyword "msgid_plural"`)
+}
+
+func TestReadLocales(t *testing.T) {
+	t.Parallel()
+	fsys := fstest.MapFS{
+		"nl.json":   {Data: []byte(`{"type": "ongeldig type"}`)},
+		"ru-RU.txt": {Data: []byte(`type = неверный тип`)},
+		"de.po":     {Data: []byte("msgctxt \"type\"\nmsgid \"invalid type\"\nmsgstr \"Ungültiger Typ\"\n")},
+		"README.md": {Data: []byte(`# Translations`)},
+	}
+	locales, err := valdo.ReadLocales(fsys)
+	noErr(err)
+	isEq(len(locales), 3)
+	isEq(locales["nl"][valdo.ErrType{}], "ongeldig type")
+	isEq(locales["ru-RU"][valdo.ErrType{}], "неверный тип")
+	isEq(locales["de"][valdo.ErrType{}], "Ungültiger Typ")
+
+	fsys["fr.json"] = &fstest.MapFile{Data: []byte(`{"typ": "type invalide"}`)}
+	_, err = valdo.ReadLocales(fsys)
+	isEq(err.Error(), `read fr.json: unknown error code "typ"`)
+}
+
+func TestLocale_WriteJSON(t *testing.T) {
+	t.Parallel()
+	loc := valdo.Locale{
+		valdo.ErrMinLen{}: "minimum {value}",
+		valdo.ErrType{}:   "wrong type",
+	}
+	var buf bytes.Buffer
+	noErr(loc.WriteJSON(&buf))
+	isEq(buf.String(), "{\n  \"type\": \"wrong type\",\n  \"min_len\": \"minimum {value}\"\n}\n")
+}
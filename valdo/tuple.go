@@ -1,6 +1,10 @@
 package valdo
 
-import "github.com/orsinium-labs/jsony"
+import (
+	"context"
+
+	"github.com/orsinium-labs/jsony"
+)
 
 type TupleType struct {
 	cs       []Constraint[[]any]
@@ -31,15 +35,20 @@ func (t TupleType) AllowExtra(v Validator) TupleType {
 
 // Validate implements [Validator].
 func (t TupleType) Validate(data any) Error {
+	return t.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (t TupleType) ValidateContext(ctx context.Context, data any) Error {
 	switch d := data.(type) {
 	case []any:
-		return t.validateArray(d)
+		return t.validateArray(ctx, d)
 	default:
 		return ErrType{Got: getTypeName(data), Expected: "array"}
 	}
 }
 
-func (t TupleType) validateArray(data []any) Error {
+func (t TupleType) validateArray(ctx context.Context, data []any) Error {
 	if data == nil {
 		return ErrType{Got: "null", Expected: "array"}
 	}
@@ -52,7 +61,7 @@ func (t TupleType) validateArray(data []any) Error {
 	res := Errors{}
 	for i, validator := range t.vals {
 		value := data[i]
-		err := validator.Validate(value)
+		err := validateContext(warnIndex(childContext(ctx), i), validator, value)
 		if err != nil {
 			res.Add(ErrIndex{Index: i, Err: err})
 			break
@@ -61,7 +70,7 @@ func (t TupleType) validateArray(data []any) Error {
 	if t.extraVal != nil {
 		for i := len(t.vals); i < len(data); i++ {
 			value := data[i]
-			err := t.extraVal.Validate(value)
+			err := validateContext(warnIndex(childContext(ctx), i), t.extraVal, value)
 			if err != nil {
 				res.Add(ErrIndex{Index: i, Err: err})
 				break
@@ -69,22 +78,25 @@ func (t TupleType) validateArray(data []any) Error {
 		}
 	}
 	for _, c := range t.cs {
-		res.Add(c.check(data))
+		res.Add(c.validate(ctx, data))
+	}
+	ann := getAnnotations(ctx)
+	ann.addPrefix(len(t.vals))
+	if t.extraVal != nil || !t.extra {
+		ann.addAllItems()
 	}
 	return res.Flatten()
 }
 
 // Schema implements [Validator].
 func (t TupleType) Schema() jsony.Object {
-	var items jsony.Encoder
-	if t.extraVal != nil {
-		items = t.extraVal.Schema()
-	} else {
-		items = jsony.Bool(false)
-	}
 	res := jsony.Object{
 		jsony.Field{K: "type", V: jsony.SafeString("array")},
-		jsony.Field{K: "items", V: items},
+	}
+	if t.extraVal != nil {
+		res = append(res, jsony.Field{K: "items", V: t.extraVal.Schema()})
+	} else if !t.extra {
+		res = append(res, jsony.Field{K: "items", V: jsony.False})
 	}
 	if len(t.vals) > 0 {
 		items := make([]jsony.Object, len(t.vals))
@@ -93,8 +105,5 @@ func (t TupleType) Schema() jsony.Object {
 		}
 		res = append(res, jsony.Field{K: "prefixItems", V: jsony.Array[jsony.Object](items)})
 	}
-	for _, c := range t.cs {
-		res = append(res, c.field)
-	}
-	return res
+	return appendConstraints(res, t.cs)
 }
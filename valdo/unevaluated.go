@@ -0,0 +1,283 @@
+package valdo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/orsinium-labs/jsony"
+)
+
+// annotations collects properties and items evaluated by validators
+// at the same location of the JSON document.
+//
+// It's used to implement [UnevaluatedProperties] and [UnevaluatedItems].
+type annotations struct {
+	mu       sync.Mutex
+	props    map[string]struct{}
+	allProps bool
+	items    map[int]struct{}
+	allItems bool
+	prefix   int
+}
+
+type annotationsKey struct{}
+
+// getAnnotations returns the annotations collector from the context, if any.
+func getAnnotations(ctx context.Context) *annotations {
+	ann, _ := ctx.Value(annotationsKey{}).(*annotations)
+	return ann
+}
+
+// withAnnotations returns a context with a new annotations collector.
+func withAnnotations(ctx context.Context) (context.Context, *annotations) {
+	ann := &annotations{}
+	return context.WithValue(ctx, annotationsKey{}, ann), ann
+}
+
+// childContext returns the context for validating a nested value
+// (a property or an item), which must not be recorded as evaluated
+// in the annotations of the parent.
+func childContext(ctx context.Context) context.Context {
+	if getAnnotations(ctx) == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, annotationsKey{}, (*annotations)(nil))
+}
+
+// addProp marks the property as evaluated.
+func (ann *annotations) addProp(name string) {
+	if ann == nil {
+		return
+	}
+	ann.mu.Lock()
+	defer ann.mu.Unlock()
+	if ann.props == nil {
+		ann.props = make(map[string]struct{})
+	}
+	ann.props[name] = struct{}{}
+}
+
+// addAllProps marks all properties as evaluated.
+func (ann *annotations) addAllProps() {
+	if ann == nil {
+		return
+	}
+	ann.mu.Lock()
+	defer ann.mu.Unlock()
+	ann.allProps = true
+}
+
+// addItem marks the item at the given index as evaluated.
+func (ann *annotations) addItem(idx int) {
+	if ann == nil {
+		return
+	}
+	ann.mu.Lock()
+	defer ann.mu.Unlock()
+	if ann.items == nil {
+		ann.items = make(map[int]struct{})
+	}
+	ann.items[idx] = struct{}{}
+}
+
+// addPrefix marks the given number of the first items as evaluated.
+func (ann *annotations) addPrefix(n int) {
+	if ann == nil {
+		return
+	}
+	ann.mu.Lock()
+	defer ann.mu.Unlock()
+	ann.prefix = max(ann.prefix, n)
+}
+
+// addAllItems marks all items as evaluated.
+func (ann *annotations) addAllItems() {
+	if ann == nil {
+		return
+	}
+	ann.mu.Lock()
+	defer ann.mu.Unlock()
+	ann.allItems = true
+}
+
+// merge the annotations from another collector.
+func (ann *annotations) merge(other *annotations) {
+	if ann == nil {
+		return
+	}
+	for name := range other.props {
+		ann.addProp(name)
+	}
+	for idx := range other.items {
+		ann.addItem(idx)
+	}
+	if other.allProps {
+		ann.addAllProps()
+	}
+	if other.allItems {
+		ann.addAllItems()
+	}
+	ann.addPrefix(other.prefix)
+}
+
+func (ann *annotations) hasProp(name string) bool {
+	if ann.allProps {
+		return true
+	}
+	_, found := ann.props[name]
+	return found
+}
+
+func (ann *annotations) hasItem(idx int) bool {
+	if ann.allItems || idx < ann.prefix {
+		return true
+	}
+	_, found := ann.items[idx]
+	return found
+}
+
+type unevaluatedProps struct {
+	v     Validator
+	extra Validator
+}
+
+// UnevaluatedProperties applies to object properties that weren't evaluated
+// by the validator, including validators combined using [AllOf] and [AnyOf].
+//
+// If extra is nil, such properties are rejected with [ErrUnexpected].
+// Otherwise, they are validated using the extra validator.
+//
+// It makes it possible to combine objects while still rejecting unknown properties.
+// Use [ObjectType.AllowExtra] with nil on the combined objects,
+// so that they don't reject properties of each other:
+//
+//	valdo.UnevaluatedProperties(valdo.AllOf(
+//		base.AllowExtra(nil),
+//		extension.AllowExtra(nil),
+//	), nil)
+//
+// https://json-schema.org/understanding-json-schema/reference/object#unevaluatedproperties
+func UnevaluatedProperties(v Validator, extra Validator) Validator {
+	return unevaluatedProps{v: v, extra: extra}
+}
+
+// Validate implements [Validator].
+func (u unevaluatedProps) Validate(data any) Error {
+	return u.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (u unevaluatedProps) ValidateContext(ctx context.Context, data any) Error {
+	parent := getAnnotations(ctx)
+	ctx, ann := withAnnotations(ctx)
+	err := validateContext(ctx, u.v, data)
+	if err != nil {
+		return err
+	}
+	obj, ok := data.(map[string]any)
+	if !ok {
+		parent.merge(ann)
+		return nil
+	}
+	res := Errors{}
+	for name, val := range obj {
+		if ann.hasProp(name) {
+			continue
+		}
+		if u.extra == nil {
+			res.Add(ErrUnexpected{Name: name})
+			continue
+		}
+		err := validateContext(childContext(ctx), u.extra, val)
+		if err != nil {
+			res.Add(ErrProperty{Name: name, Err: err})
+		}
+	}
+	parent.addAllProps()
+	parent.merge(ann)
+	return res.Flatten()
+}
+
+// Schema implements [Validator].
+func (u unevaluatedProps) Schema() jsony.Object {
+	var extra jsony.Encoder = jsony.False
+	if u.extra != nil {
+		extra = u.extra.Schema()
+	}
+	return append(u.v.Schema(), jsony.Field{K: "unevaluatedProperties", V: extra})
+}
+
+// unwrap implements [wrapper].
+func (u unevaluatedProps) unwrap() Validator {
+	return u.v
+}
+
+type unevaluatedItems struct {
+	v     Validator
+	extra Validator
+}
+
+// UnevaluatedItems applies to array items that weren't evaluated
+// by the validator, including validators combined using [AllOf] and [AnyOf].
+//
+// If extra is nil, such items are rejected with [ErrUnexpectedItem].
+// Otherwise, they are validated using the extra validator.
+//
+// Use [TupleType.AllowExtra] with nil on the combined tuples,
+// so that they don't reject items of each other.
+//
+// https://json-schema.org/understanding-json-schema/reference/array#unevaluateditems
+func UnevaluatedItems(v Validator, extra Validator) Validator {
+	return unevaluatedItems{v: v, extra: extra}
+}
+
+// Validate implements [Validator].
+func (u unevaluatedItems) Validate(data any) Error {
+	return u.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext implements [ContextValidator].
+func (u unevaluatedItems) ValidateContext(ctx context.Context, data any) Error {
+	parent := getAnnotations(ctx)
+	ctx, ann := withAnnotations(ctx)
+	err := validateContext(ctx, u.v, data)
+	if err != nil {
+		return err
+	}
+	items, ok := data.([]any)
+	if !ok {
+		parent.merge(ann)
+		return nil
+	}
+	res := Errors{}
+	for i, val := range items {
+		if ann.hasItem(i) {
+			continue
+		}
+		if u.extra == nil {
+			res.Add(ErrIndex{Index: i, Err: ErrUnexpectedItem{}})
+			continue
+		}
+		err := validateContext(childContext(ctx), u.extra, val)
+		if err != nil {
+			res.Add(ErrIndex{Index: i, Err: err})
+		}
+	}
+	parent.addAllItems()
+	parent.merge(ann)
+	return res.Flatten()
+}
+
+// Schema implements [Validator].
+func (u unevaluatedItems) Schema() jsony.Object {
+	var extra jsony.Encoder = jsony.False
+	if u.extra != nil {
+		extra = u.extra.Schema()
+	}
+	return append(u.v.Schema(), jsony.Field{K: "unevaluatedItems", V: extra})
+}
+
+// unwrap implements [wrapper].
+func (u unevaluatedItems) unwrap() Validator {
+	return u.v
+}
@@ -0,0 +1,103 @@
+package valdo_test
+
+import (
+	"testing"
+
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestUnevaluatedProperties(t *testing.T) {
+	t.Parallel()
+	base := valdo.Object(valdo.P("name", valdo.String())).AllowExtra(nil)
+	ext := valdo.Object(valdo.P("age", valdo.Int()).Optional()).AllowExtra(nil)
+	val := valdo.UnevaluatedProperties(valdo.AllOf(base, ext), nil)
+	noErr(valdo.Validate(val, []byte(`{"name": "aragorn", "age": 87}`)))
+
+	err := valdo.Validate(val, []byte(`{"name": "aragorn", "city": "Minas Tirith"}`))
+	isErr[valdo.ErrUnexpected](err)
+	isEq(err.Error(), "unexpected property: city")
+
+	// errors of the wrapped validator are reported as is
+	err = valdo.Validate(val, []byte(`{"name": "aragorn", "age": "old", "city": "Minas Tirith"}`))
+	isErr[valdo.ErrProperty](err)
+
+	// nested objects are evaluated by their own rules
+	nested := valdo.UnevaluatedProperties(valdo.Object(
+		valdo.P("user", valdo.Object().AllowExtra(nil)),
+	).AllowExtra(nil), nil)
+	noErr(valdo.Validate(nested, []byte(`{"user": {"name": "aragorn"}}`)))
+	isErr[valdo.ErrUnexpected](valdo.Validate(nested, []byte(`{"user": {}, "name": "aragorn"}`)))
+
+	// objects not allowing extra properties evaluate all of them
+	closed := valdo.UnevaluatedProperties(valdo.Object(valdo.P("name", valdo.String())), nil)
+	noErr(valdo.Validate(closed, []byte(`{"name": "aragorn"}`)))
+}
+
+func TestUnevaluatedProperties_Extra(t *testing.T) {
+	t.Parallel()
+	base := valdo.Object(valdo.P("name", valdo.String())).AllowExtra(nil)
+	val := valdo.UnevaluatedProperties(valdo.AllOf(base), valdo.Int())
+	noErr(valdo.Validate(val, []byte(`{"name": "aragorn", "age": 87}`)))
+	err := valdo.Validate(val, []byte(`{"name": "aragorn", "age": "old"}`))
+	isErr[valdo.ErrProperty](err)
+	isEq(err.Error(), "age: invalid type: got string, expected integer")
+}
+
+func TestUnevaluatedProperties_AnyOf(t *testing.T) {
+	t.Parallel()
+	val := valdo.UnevaluatedProperties(valdo.AnyOf(
+		valdo.Object(valdo.P("name", valdo.String())).AllowExtra(nil),
+		valdo.Object(valdo.P("id", valdo.Int())).AllowExtra(nil),
+	), nil)
+	noErr(valdo.Validate(val, []byte(`{"name": "aragorn"}`)))
+	noErr(valdo.Validate(val, []byte(`{"name": "aragorn", "id": 1}`)))
+	// properties of the failed branch are not evaluated
+	err := valdo.Validate(val, []byte(`{"name": "aragorn", "id": "1"}`))
+	isErr[valdo.ErrUnexpected](err)
+	isEq(err.Error(), "unexpected property: id")
+
+	// properties checked inside of "not" are not evaluated
+	not := valdo.UnevaluatedProperties(valdo.AllOf(
+		valdo.Object().AllowExtra(nil),
+		valdo.Not(valdo.Object(valdo.P("id", valdo.String())).AllowExtra(nil)),
+	), nil)
+	isErr[valdo.ErrUnexpected](valdo.Validate(not, []byte(`{"id": 1}`)))
+}
+
+func TestUnevaluatedItems(t *testing.T) {
+	t.Parallel()
+	val := valdo.UnevaluatedItems(valdo.AllOf(
+		valdo.Tuple(valdo.String()).AllowExtra(nil),
+		valdo.Tuple(valdo.Any(), valdo.Int()).AllowExtra(nil),
+	), nil)
+	noErr(valdo.Validate(val, []byte(`["aragorn", 87]`)))
+	err := valdo.Validate(val, []byte(`["aragorn", 87, true]`))
+	isErr[valdo.ErrIndex](err)
+	isEq(err.Error(), "at 2: unexpected item")
+
+	extra := valdo.UnevaluatedItems(valdo.Tuple(valdo.String()).AllowExtra(nil), valdo.Int())
+	noErr(valdo.Validate(extra, []byte(`["aragorn", 87, 88]`)))
+	isEq(valdo.Validate(extra, []byte(`["aragorn", "87"]`)).Error(), "at 1: invalid type: got string, expected integer")
+
+	contains := valdo.UnevaluatedItems(valdo.Tuple().AllowExtra(nil).Constrain(
+		valdo.Contains(valdo.Int()),
+	), nil)
+	noErr(valdo.Validate(contains, []byte(`[1, 2]`)))
+	isEq(valdo.Validate(contains, []byte(`[1, "2"]`)).Error(), "at 1: unexpected item")
+
+	array := valdo.UnevaluatedItems(valdo.Array(valdo.Int()), nil)
+	noErr(valdo.Validate(array, []byte(`[1, 2]`)))
+
+	translated := valdo.DefaultLocales.Wrap("nl", val)
+	isEq(valdo.Validate(translated, []byte(`["aragorn", 87, true]`)).Error(), "bij 2: onverwacht element")
+}
+
+func TestUnevaluated_Schema(t *testing.T) {
+	t.Parallel()
+	val := valdo.UnevaluatedProperties(valdo.Object().AllowExtra(nil), nil)
+	isEq(string(valdo.Schema(val)), `{"type":"object","unevaluatedProperties":false}`)
+	val = valdo.UnevaluatedProperties(valdo.Object().AllowExtra(nil), valdo.Int())
+	isEq(string(valdo.Schema(val)), `{"type":"object","unevaluatedProperties":{"type":"integer"}}`)
+	val = valdo.UnevaluatedItems(valdo.Tuple().AllowExtra(nil), nil)
+	isEq(string(valdo.Schema(val)), `{"type":"array","unevaluatedItems":false}`)
+}
@@ -0,0 +1,188 @@
+package valdo
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/orsinium-labs/jsony"
+)
+
+// UniqueItems requires all items of the array to be unique.
+//
+// Items are compared as JSON values: numbers are equal if they have the same value
+// (1 and 1.0 are equal) and objects are equal regardless of the order of keys.
+// Each duplicate is reported as [ErrUniqueItems] pointing to the first occurrence.
+//
+// https://json-schema.org/understanding-json-schema/reference/array#uniqueItems
+func UniqueItems() Constraint[[]any] {
+	c := func(items []any) Error {
+		return findDuplicates(items, func(item any) (any, bool) {
+			return item, true
+		})
+	}
+	return Constraint[[]any]{
+		check:  c,
+		fields: jsony.Object{{K: "uniqueItems", V: jsony.True}},
+	}
+}
+
+// UniqueBy requires the values at the given JSON pointer to be unique across array items.
+//
+// For example, "/id" requires all objects in the array to have a unique "id".
+// Items that don't have a value at the pointer are ignored.
+// Values are compared the same way as in [UniqueItems].
+//
+// There is no JSON Schema keyword for it, so "x-uniqueBy" extension keyword is used.
+//
+// https://datatracker.ietf.org/doc/html/rfc6901
+func UniqueBy(pointer string) Constraint[[]any] {
+	tokens := parsePointer(pointer)
+	c := func(items []any) Error {
+		return findDuplicates(items, func(item any) (any, bool) {
+			return resolvePointer(item, tokens)
+		})
+	}
+	return Constraint[[]any]{
+		check:  c,
+		fields: jsony.Object{{K: "x-uniqueBy", V: jsony.String(pointer)}},
+	}
+}
+
+// findDuplicates reports every item with the same key as one of the previous items.
+func findDuplicates(items []any, getKey func(any) (any, bool)) Error {
+	seen := make(map[string]int, len(items))
+	res := Errors{}
+	var b strings.Builder
+	for i, item := range items {
+		key, ok := getKey(item)
+		if !ok {
+			continue
+		}
+		b.Reset()
+		writeCanonical(&b, key)
+		first, found := seen[b.String()]
+		if found {
+			res.Add(ErrIndex{Index: i, Err: ErrUniqueItems{Index: first}})
+			continue
+		}
+		seen[b.String()] = i
+	}
+	return res.Flatten()
+}
+
+// writeCanonical writes a representation of the JSON value that is the same
+// for all equal values, so that it can be used as a map key.
+func writeCanonical(b *strings.Builder, v any) {
+	switch v := v.(type) {
+	case nil:
+		b.WriteString("null")
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+	case string:
+		b.WriteString(strconv.Quote(v))
+	case []any:
+		b.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeCanonical(b, item)
+		}
+		b.WriteByte(']')
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		b.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(strconv.Quote(key))
+			b.WriteByte(':')
+			writeCanonical(b, v[key])
+		}
+		b.WriteByte('}')
+	default:
+		f, err := float64Validator(v)
+		if err == nil {
+			b.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+			return
+		}
+		s, err := stringValidator(v)
+		if err == nil {
+			b.WriteString(strconv.Quote(s))
+			return
+		}
+		bl, err := boolValidator(v)
+		if err == nil {
+			b.WriteString(strconv.FormatBool(bl))
+			return
+		}
+		writeEncoded(b, v)
+	}
+}
+
+// writeEncoded writes the canonical representation of a Go value of an unknown type.
+//
+// The value is encoded as JSON and decoded back to get a JSON value.
+// If it cannot be encoded, its Go type and value are written in angle brackets,
+// which never occurs in the representation of JSON values.
+func writeEncoded(b *strings.Builder, v any) {
+	raw, err := json.Marshal(v)
+	if err == nil {
+		var decoded any
+		err = json.Unmarshal(raw, &decoded)
+		if err == nil {
+			writeCanonical(b, decoded)
+			return
+		}
+	}
+	fmt.Fprintf(b, "<%T %#v>", v, v)
+}
+
+// parsePointer splits the JSON pointer into unescaped reference tokens.
+//
+// Panics if the pointer is not valid.
+func parsePointer(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+	if pointer[0] != '/' {
+		panic("JSON pointer must start with /")
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		token = strings.ReplaceAll(token, "~1", "/")
+		tokens[i] = strings.ReplaceAll(token, "~0", "~")
+	}
+	return tokens
+}
+
+// resolvePointer returns the value at the parsed JSON pointer.
+func resolvePointer(v any, tokens []string) (any, bool) {
+	for _, token := range tokens {
+		switch node := v.(type) {
+		case map[string]any:
+			var found bool
+			v, found = node[token]
+			if !found {
+				return nil, false
+			}
+		case []any:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, false
+			}
+			v = node[idx]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
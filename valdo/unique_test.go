@@ -0,0 +1,59 @@
+package valdo_test
+
+import (
+	"testing"
+
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestUniqueItems(t *testing.T) {
+	t.Parallel()
+	val := valdo.Array(valdo.Any(), valdo.UniqueItems())
+	noErr(valdo.Validate(val, []byte(`[]`)))
+	noErr(valdo.Validate(val, []byte(`[1, "1", true, null, [1], {"a": 1}]`)))
+	noErr(valdo.Validate(val, []byte(`[{"a": 1, "b": 2}, {"a": 2, "b": 1}]`)))
+	noErr(valdo.Validate(val, []byte(`[[1, 2], [2, 1]]`)))
+
+	err := valdo.Validate(val, []byte(`[1, 2, 1.0]`))
+	isErr[valdo.ErrIndex](err)
+	isEq(err.Error(), "at 2: must be unique, the same as the item at 0")
+	err = valdo.Validate(val, []byte(`[{"a": 1, "b": [2]}, 3, {"b": [2.0], "a": 1}, 3, 3]`))
+	isEq(err.Error(), "at 2: must be unique, the same as the item at 0; at 3: must be unique, the same as the item at 1; at 4: must be unique, the same as the item at 1")
+
+	isEq(string(valdo.Schema(val)), `{"type":"array","uniqueItems":true}`)
+
+	tuple := valdo.Tuple(valdo.Int(), valdo.Int()).Constrain(valdo.UniqueItems())
+	noErr(valdo.Validate(tuple, []byte(`[1, 2]`)))
+	isErr[valdo.ErrIndex](valdo.Validate(tuple, []byte(`[2, 2]`)))
+}
+
+func TestUniqueItems_GoValues(t *testing.T) {
+	t.Parallel()
+	val := valdo.Array(valdo.Any(), valdo.UniqueItems())
+	type point struct{ X, Y int }
+	noErr(val.Validate([]any{
+		"integer", int8(1), "number", uint(2), "[1,2]", []int{1, 2},
+		map[string]int{"a": 1}, point{1, 2}, make(chan int), make(chan int),
+	}))
+	err := val.Validate([]any{[]int{1, 2}, []any{1.0, 2.0}})
+	isEq(err.Error(), "at 1: must be unique, the same as the item at 0")
+	err = val.Validate([]any{point{1, 2}, map[string]any{"Y": 2, "X": 1}})
+	isEq(err.Error(), "at 1: must be unique, the same as the item at 0")
+}
+
+func TestUniqueBy(t *testing.T) {
+	t.Parallel()
+	val := valdo.Array(valdo.Any(), valdo.UniqueBy("/id"))
+	noErr(valdo.Validate(val, []byte(`[{"id": 1}, {"id": 2}, {}, {}, 3, 3]`)))
+	err := valdo.Validate(val, []byte(`[{"id": 1, "name": "a"}, {"id": 2}, {"id": 1, "name": "b"}]`))
+	isEq(err.Error(), "at 2: must be unique, the same as the item at 0")
+	isEq(string(valdo.Schema(val)), `{"type":"array","x-uniqueBy":"/id"}`)
+
+	val = valdo.Array(valdo.Any(), valdo.UniqueBy("/a~1b/0"))
+	noErr(valdo.Validate(val, []byte(`[{"a/b": [1, 2]}, {"a/b": [2, 2]}]`)))
+	isErr[valdo.ErrIndex](valdo.Validate(val, []byte(`[{"a/b": [1, 2]}, {"a/b": [1, 3]}]`)))
+
+	translated := valdo.DefaultLocales.Wrap("nl", valdo.Array(valdo.Any(), valdo.UniqueBy("/id")))
+	err = valdo.Validate(translated, []byte(`[{"id": 1}, {"id": 1}]`))
+	isEq(err.Error(), "bij 1: moet uniek zijn, gelijk aan het item bij 0")
+}
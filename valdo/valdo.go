@@ -1,6 +1,7 @@
 package valdo
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/orsinium-labs/jsony"
@@ -24,24 +25,64 @@ type Validator interface {
 	Schema() jsony.Object
 }
 
+// ContextValidator is a [Validator] that can pass a context to context-aware checks,
+// like [CheckContext] and [Exists].
+//
+// All built-in validators implement it.
+type ContextValidator interface {
+	Validator
+	ValidateContext(ctx context.Context, data any) Error
+}
+
+// validateContext validates the data with the context if the validator supports it.
+func validateContext(ctx context.Context, v Validator, data any) Error {
+	cv, ok := v.(ContextValidator)
+	if ok {
+		return cv.ValidateContext(ctx, data)
+	}
+	return v.Validate(data)
+}
+
 // Schema generates JSON Schema for the validator.
 func Schema(v Validator) []byte {
 	return jsony.EncodeBytes(v.Schema())
 }
 
 // Read the input JSON, validate it, and unmarshal into the given type.
+//
+// The data is transformed by [Sanitize] before unmarshaling.
+// Inside of [WithDefaults], absent optional properties
+// are filled in with their default values.
 func Unmarshal[T any](v Validator, input []byte) (T, error) {
+	return UnmarshalContext[T](context.Background(), v, input)
+}
+
+// Like [Unmarshal] but passes the context to context-aware checks.
+func UnmarshalContext[T any](ctx context.Context, v Validator, input []byte) (T, error) {
 	var target T
-	err := Validate(v, input)
+	err := ValidateContext(ctx, v, input)
 	if err != nil {
 		return target, err
 	}
+	if needsPrepare(v) {
+		input, err = prepareInput(ctx, v, input)
+		if err != nil {
+			return target, err
+		}
+	}
 	err = json.Unmarshal(input, &target)
 	return target, err
 }
 
 // Validate the given JSON.
 func Validate(v Validator, input []byte) error {
+	return ValidateContext(context.Background(), v, input)
+}
+
+// Like [Validate] but passes the context to context-aware checks.
+//
+// If the context is canceled, context-aware checks fail with [ErrCheck].
+func ValidateContext(ctx context.Context, v Validator, input []byte) error {
 	if len(input) == 0 {
 		return ErrNoInput{}
 	}
@@ -50,7 +91,7 @@ func Validate(v Validator, input []byte) error {
 	if err != nil {
 		return err
 	}
-	vErr := v.Validate(data)
+	vErr := validateContext(ctx, v, data)
 	if vErr != nil {
 		return vErr
 	}
@@ -0,0 +1,75 @@
+package valdo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// wrapper is implemented by validators that wrap a single validator,
+// like [Meta], [WithMessage], or [Sanitize].
+//
+// Code looking for validators of a specific type, like [ObjectType],
+// must look through the wrappers.
+type wrapper interface {
+	unwrap() Validator
+}
+
+// walk calls f for the validator and all validators nested into it.
+//
+// The location passed into f is a JSON pointer to the schema
+// of the validator in the generated JSON Schema.
+func walk(v Validator, loc string, f func(loc string, v Validator)) {
+	f(loc, v)
+	switch v := v.(type) {
+	case ArrayType:
+		walk(v.elem, loc+"/items", f)
+	case TupleType:
+		for i, val := range v.vals {
+			walk(val, loc+"/prefixItems/"+strconv.Itoa(i), f)
+		}
+		if v.extraVal != nil {
+			walk(v.extraVal, loc+"/items", f)
+		}
+	case ObjectType:
+		for _, p := range v.ps {
+			kw := "/properties/"
+			if p.rex != nil {
+				kw = "/patternProperties/"
+			}
+			walk(p.validator, loc+kw+escapePointer(p.name), f)
+		}
+		if v.extraVal != nil {
+			walk(v.extraVal, loc+"/additionalProperties", f)
+		}
+	case allOf:
+		for i, val := range v.vs {
+			walk(val, loc+"/allOf/"+strconv.Itoa(i), f)
+		}
+	case anyOf:
+		for i, val := range v.vs {
+			walk(val, loc+"/anyOf/"+strconv.Itoa(i), f)
+		}
+	case notType:
+		walk(v.v, loc+"/not", f)
+	case unevaluatedProps:
+		walk(v.v, loc, f)
+		if v.extra != nil {
+			walk(v.extra, loc+"/unevaluatedProperties", f)
+		}
+	case unevaluatedItems:
+		walk(v.v, loc, f)
+		if v.extra != nil {
+			walk(v.extra, loc+"/unevaluatedItems", f)
+		}
+	case wrapper:
+		walk(v.unwrap(), loc, f)
+	}
+}
+
+// escapePointer escapes the reference token of a JSON pointer.
+//
+// It's the inverse of the unescaping done by [parsePointer].
+func escapePointer(token string) string {
+	token = strings.ReplaceAll(token, "~", "~0")
+	return strings.ReplaceAll(token, "/", "~1")
+}
@@ -0,0 +1,169 @@
+package valdo
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// Warnings collects non-fatal problems found during validation:
+// unknown properties dropped by [ObjectType.StripUnknown],
+// present values marked as Deprecated in [Meta] or [EnumOf] ([ErrDeprecated]),
+// and failed soft constraints (see [Warn]).
+//
+// Warnings are wrapped into [ErrProperty] and [ErrIndex] the same way as errors.
+// It's safe for concurrent use.
+type Warnings struct {
+	mu   sync.Mutex
+	errs []Error
+}
+
+// WithWarnings returns a context for [ValidateContext] that collects warnings.
+//
+//	ctx, warnings := valdo.WithWarnings(r.Context())
+//	err := valdo.ValidateContext(ctx, validator, body)
+//	for _, w := range warnings.Errors() {
+//		log.Println(w)
+//	}
+func WithWarnings(ctx context.Context) (context.Context, *Warnings) {
+	w := &Warnings{}
+	return context.WithValue(ctx, warningsKey{}, &warnNode{sink: w}), w
+}
+
+// Errors returns the collected warnings.
+func (w *Warnings) Errors() []Error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	res := make([]Error, len(w.errs))
+	copy(res, w.errs)
+	return res
+}
+
+func (w *Warnings) add(err Error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.errs = append(w.errs, err)
+}
+
+// Warn turns the constraint into a soft one: if it fails,
+// the error is reported as a warning instead of failing the validation.
+//
+// The constraint is not included in the schema.
+//
+//	valdo.String(valdo.Warn(valdo.MaxLen(100)))
+func Warn[T any](c Constraint[T]) Constraint[T] {
+	check := func(ctx context.Context, v T) Error {
+		err := c.validate(ctx, v)
+		if err != nil {
+			addWarning(ctx, err)
+		}
+		return nil
+	}
+	return Constraint[T]{checkCtx: check}
+}
+
+// WarningHeader formats the warnings as a value of the Warning HTTP header.
+//
+// Each warning is a separate value with the code 299 (miscellaneous persistent warning).
+// Returns an empty string if there are no warnings.
+//
+// https://www.rfc-editor.org/rfc/rfc7234#section-5.5
+func WarningHeader(warnings []Error) string {
+	var b strings.Builder
+	for i, w := range warnings {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(`299 - "`)
+		for _, r := range w.Error() {
+			switch {
+			case r == '"' || r == '\\':
+				b.WriteByte('\\')
+				b.WriteRune(r)
+			case r < ' ' || r == 0x7f:
+				// Control characters, like new lines, are not allowed in headers.
+				b.WriteByte(' ')
+			default:
+				b.WriteRune(r)
+			}
+		}
+		b.WriteByte('"')
+	}
+	return b.String()
+}
+
+type warningsKey struct{}
+
+// warnNode is a location in the validated data where warnings are reported.
+type warnNode struct {
+	sink   *Warnings
+	parent *warnNode
+	// wrap the warning, for example, into [ErrProperty].
+	wrap func(Error) Error
+}
+
+// warnWrap returns a context in which all warnings are wrapped using the function.
+//
+// If the context doesn't collect warnings, it is returned as is.
+func warnWrap(ctx context.Context, wrap func(Error) Error) context.Context {
+	node, _ := ctx.Value(warningsKey{}).(*warnNode)
+	if node == nil {
+		return ctx
+	}
+	child := &warnNode{sink: node.sink, parent: node, wrap: wrap}
+	return context.WithValue(ctx, warningsKey{}, child)
+}
+
+// warnProperty returns a context in which warnings are wrapped into [ErrProperty].
+func warnProperty(ctx context.Context, name string) context.Context {
+	return warnWrap(ctx, func(err Error) Error {
+		return ErrProperty{Name: name, Err: err}
+	})
+}
+
+// warnIndex returns a context in which warnings are wrapped into [ErrIndex].
+func warnIndex(ctx context.Context, idx int) context.Context {
+	return warnWrap(ctx, func(err Error) Error {
+		return ErrIndex{Index: idx, Err: err}
+	})
+}
+
+// addWarning reports the warning if the context collects warnings.
+func addWarning(ctx context.Context, err Error) {
+	node, _ := ctx.Value(warningsKey{}).(*warnNode)
+	if node == nil {
+		return
+	}
+	sink := node.sink
+	for ; node != nil; node = node.parent {
+		if node.wrap != nil {
+			err = node.wrap(err)
+		}
+	}
+	sink.add(err)
+}
+
+// warnBuffer returns a context in which warnings are collected into a separate buffer
+// instead of being reported right away.
+//
+// Used for speculative validation, like branches of [AnyOf]: warnings of the branch
+// are reported using [mergeWarnings] only if the branch matched.
+// If the context doesn't collect warnings, the returned buffer is nil.
+func warnBuffer(ctx context.Context) (context.Context, *Warnings) {
+	node, _ := ctx.Value(warningsKey{}).(*warnNode)
+	if node == nil {
+		return ctx, nil
+	}
+	buf := &Warnings{}
+	return context.WithValue(ctx, warningsKey{}, &warnNode{sink: buf}), buf
+}
+
+// mergeWarnings reports the warnings collected by [warnBuffer] in the given context.
+func mergeWarnings(ctx context.Context, buf *Warnings) {
+	if buf == nil {
+		return
+	}
+	for _, err := range buf.Errors() {
+		addWarning(ctx, err)
+	}
+}
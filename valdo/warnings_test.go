@@ -0,0 +1,151 @@
+package valdo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/orsinium-labs/valdo/valdo"
+)
+
+func TestObject_StripUnknown(t *testing.T) {
+	t.Parallel()
+	val := valdo.Object(
+		valdo.P("name", valdo.String()),
+		valdo.P("pets", valdo.Array(valdo.Object(valdo.P("name", valdo.String())))),
+		valdo.P("^x-", valdo.String()).Optional(),
+	).StripUnknown()
+	input := []byte(`{"name": "aragorn", "pets": [{"name": "brego", "age": 3}], "x-race": "human", "city": "Minas Tirith"}`)
+	noErr(valdo.Validate(val, input))
+	isErr[valdo.ErrProperty](valdo.Validate(val, []byte(`{"name": 1, "pets": [], "city": "Minas Tirith"}`)))
+	isEq(string(valdo.Schema(valdo.Object().StripUnknown())), `{"type":"object","additionalProperties":false}`)
+
+	ctx, warnings := valdo.WithWarnings(context.Background())
+	noErr(valdo.ValidateContext(ctx, val, input))
+	ws := warnings.Errors()
+	isEq(len(ws), 2)
+	isErr[valdo.ErrProperty](ws[0])
+	isEq(ws[0].Error(), "pets: at 0: unexpected property: age")
+	isErr[valdo.ErrUnexpected](ws[1])
+	isEq(ws[1].Error(), "unexpected property: city")
+
+	res, err := valdo.Clean(val, input)
+	noErr(err)
+	obj := res.(map[string]any)
+	isEq(len(obj), 3)
+	isEq(obj["x-race"], any("human"))
+	isEq(len(obj["pets"].([]any)[0].(map[string]any)), 1)
+
+	m, err := valdo.Unmarshal[map[string]any](val, input)
+	noErr(err)
+	isEq(len(m), 3)
+
+	// allowing extra properties disables stripping
+	extra := valdo.Object().StripUnknown().AllowExtra(nil)
+	m, err = valdo.Unmarshal[map[string]any](extra, []byte(`{"city": "Minas Tirith"}`))
+	noErr(err)
+	isEq(len(m), 1)
+}
+
+func TestWithWarnings_Translated(t *testing.T) {
+	t.Parallel()
+	val := valdo.DefaultLocales.Wrap("nl", valdo.Array(valdo.Object().StripUnknown()))
+	ctx, warnings := valdo.WithWarnings(context.Background())
+	noErr(valdo.ValidateContext(ctx, val, []byte(`[{}, {"city": "Minas Tirith"}]`)))
+	ws := warnings.Errors()
+	isEq(len(ws), 1)
+	isEq(ws[0].Error(), "bij 1: onverwachte eigenschap: city")
+}
+
+func TestWarnings_Deprecated(t *testing.T) {
+	t.Parallel()
+	val := valdo.Object(
+		valdo.P("name", valdo.String()),
+		valdo.P("nick", valdo.Meta{Validator: valdo.String(), Deprecated: true}).Optional(),
+	)
+	ctx, warnings := valdo.WithWarnings(context.Background())
+	noErr(valdo.ValidateContext(ctx, val, []byte(`{"name": "aragorn"}`)))
+	isEq(len(warnings.Errors()), 0)
+	noErr(valdo.ValidateContext(ctx, val, []byte(`{"name": "aragorn", "nick": "strider"}`)))
+	ws := warnings.Errors()
+	isEq(len(ws), 1)
+	isErr[valdo.ErrProperty](ws[0])
+	isErr[valdo.ErrDeprecated](ws[0].(valdo.ErrProperty).Err)
+	isEq(ws[0].Error(), "nick: is deprecated")
+
+	ctx, warnings = valdo.WithWarnings(context.Background())
+	translated := valdo.DefaultLocales.Wrap("de", val)
+	noErr(valdo.ValidateContext(ctx, translated, []byte(`{"name": "aragorn", "nick": "strider"}`)))
+	isEq(warnings.Errors()[0].Error(), "nick: Ist veraltet")
+}
+
+func TestWarnings_AnyOf(t *testing.T) {
+	t.Parallel()
+	dep := valdo.Meta{Validator: valdo.String(), Deprecated: true}
+	val := valdo.AnyOf(
+		valdo.Object(valdo.P("status", dep), valdo.P("kind", valdo.StringConst("a"))),
+		valdo.Object(valdo.P("status", valdo.String()), valdo.P("kind", valdo.StringConst("b"))),
+	)
+	ctx, warnings := valdo.WithWarnings(context.Background())
+	noErr(valdo.ValidateContext(ctx, val, []byte(`{"status": "x", "kind": "b"}`)))
+	isEq(len(warnings.Errors()), 0)
+
+	noErr(valdo.ValidateContext(ctx, val, []byte(`{"status": "x", "kind": "a"}`)))
+	ws := warnings.Errors()
+	isEq(len(ws), 1)
+	isEq(ws[0].Error(), "status: is deprecated")
+
+	// the branches are validated again when applying transforms, without new warnings
+	ctx, warnings = valdo.WithWarnings(context.Background())
+	stripped := valdo.Array(valdo.AnyOf(valdo.Object(valdo.P("status", dep)).StripUnknown()))
+	_, err := valdo.UnmarshalContext[[]map[string]any](ctx, stripped, []byte(`[{"status": "x"}]`))
+	noErr(err)
+	ws = warnings.Errors()
+	isEq(len(ws), 1)
+	isEq(ws[0].Error(), "at 0: status: is deprecated")
+
+	ctx, warnings = valdo.WithWarnings(context.Background())
+	noErr(valdo.ValidateContext(ctx, valdo.Not(valdo.Bool()), []byte(`"x"`)))
+	noErr(valdo.ValidateContext(ctx, valdo.Not(dep), []byte(`1`)))
+	isEq(len(warnings.Errors()), 0)
+}
+
+func TestWarnings_Contains(t *testing.T) {
+	t.Parallel()
+	dep := valdo.Meta{Validator: valdo.String(), Deprecated: true}
+	val := valdo.Array(valdo.Any(), valdo.Contains(dep), valdo.MaxContains(dep, 1))
+	ctx, warnings := valdo.WithWarnings(context.Background())
+	noErr(valdo.ValidateContext(ctx, val, []byte(`[1, "x", true]`)))
+	ws := warnings.Errors()
+	isEq(len(ws), 2)
+	isErr[valdo.ErrIndex](ws[0])
+	isEq(ws[0].Error(), "at 1: is deprecated")
+	isEq(ws[1].Error(), "at 1: is deprecated")
+
+	ctx, warnings = valdo.WithWarnings(context.Background())
+	isErr[valdo.Errors](valdo.ValidateContext(ctx, val, []byte(`[1, 2]`)))
+	isEq(len(warnings.Errors()), 0)
+}
+
+func TestWarn(t *testing.T) {
+	t.Parallel()
+	val := valdo.Array(valdo.String(valdo.Warn(valdo.MaxLen(3))), valdo.Warn(valdo.MaxItems(1)))
+	isEq(string(valdo.Schema(val)), `{"type":"array","items":{"type":"string"}}`)
+	noErr(valdo.Validate(val, []byte(`["aragorn", "legolas"]`)))
+
+	ctx, warnings := valdo.WithWarnings(context.Background())
+	noErr(valdo.ValidateContext(ctx, val, []byte(`["aragorn", "sam"]`)))
+	ws := warnings.Errors()
+	isEq(len(ws), 2)
+	isEq(ws[0].Error(), "at 0: must be at most 3 characters long")
+	isErr[valdo.ErrMaxItems](ws[1])
+	isEq(valdo.WarningHeader(ws), `299 - "at 0: must be at most 3 characters long", 299 - "must contain at most 1 item"`)
+
+	isErr[valdo.ErrIndex](valdo.Validate(val, []byte(`["aragorn", 1]`)))
+}
+
+func TestWarningHeader(t *testing.T) {
+	t.Parallel()
+	isEq(valdo.WarningHeader(nil), "")
+	ws := []valdo.Error{valdo.ErrUnexpected{Name: "a\"b\\c\r\nd"}}
+	isEq(valdo.WarningHeader(ws), `299 - "unexpected property: a\"b\\c  d"`)
+}